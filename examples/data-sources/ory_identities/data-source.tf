@@ -0,0 +1,7 @@
+data "ory_identities" "admins" {
+  ids = var.admin_identity_ids
+}
+
+output "admin_emails" {
+  value = [for identity in data.ory_identities.admins.identities : jsondecode(identity.traits).email]
+}
@@ -0,0 +1,7 @@
+data "ory_identity" "admin" {
+  credentials_identifier = "admin@example.com"
+}
+
+output "admin_identity_id" {
+  value = data.ory_identity.admin.id
+}
@@ -0,0 +1,5 @@
+data "ory_workspace_projects" "all" {}
+
+output "production_projects" {
+  value = [for project in data.ory_workspace_projects.all.projects : project.slug if project.environment == "prod"]
+}
@@ -0,0 +1,8 @@
+# Compute the RFC 6902 operations between two configuration documents
+output "config_patch" {
+  value = provider::ory::json_patch(
+    jsonencode({ session = { lifespan = "24h" } }),
+    jsonencode({ session = { lifespan = "48h" } })
+  )
+  # [{"op":"replace","path":"/session/lifespan","value":"48h"}]
+}
@@ -0,0 +1,13 @@
+# Encode a jsonnet file for use as an HTTP courier action body
+resource "ory_email_configuration" "http" {
+  server_type = "http"
+
+  http_config = {
+    url                 = "https://ory.sh"
+    request_method      = "POST"
+    authentication_type = "none"
+    action_body         = provider::ory::jsonnet_body(file("./body.jsonnet"))
+  }
+
+  smtp_headers = []
+}
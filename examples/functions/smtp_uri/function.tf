@@ -0,0 +1,11 @@
+# Build the SMTP connection URI used by the Ory courier
+output "smtp_connection_uri" {
+  value     = provider::ory::smtp_uri("username", "password", "smtp.examplecompany.com", "587", "starttls")
+  sensitive = true
+}
+
+# Additional connection URI parameters can be passed as an optional map
+output "smtp_connection_uri_with_options" {
+  value     = provider::ory::smtp_uri("username", "password", "smtp.examplecompany.com", "465", "implicittls", { server_name = "mail.examplecompany.com" })
+  sensitive = true
+}
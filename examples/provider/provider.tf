@@ -3,4 +3,7 @@ provider "ory" {
   host              = "api.console.ory.sh"
   workspace_api_key = "ory_wak_1234567890"
   project_id        = "project-id-guid-here"
+
+  # Optional, used for the project admin APIs such as OAuth2 clients
+  project_api_key = "ory_pat_1234567890"
 }
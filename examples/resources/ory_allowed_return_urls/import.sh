@@ -0,0 +1,2 @@
+# Authoritative allowed return URLs can be imported by specifying this string identifier.
+terraform import ory_allowed_return_urls.example "allowed_return_urls"
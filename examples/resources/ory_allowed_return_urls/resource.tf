@@ -0,0 +1,15 @@
+# Manage the whole list of allowed return URLs
+resource "ory_allowed_return_urls" "example" {
+  urls = [
+    "https://app.example.com",
+    "https://*.preview.example.com",
+  ]
+
+  default_browser_return_url = "https://app.example.com/welcome"
+}
+
+# Or let each frontend add its own URLs
+resource "ory_allowed_return_urls" "frontend" {
+  mode = "additive"
+  urls = ["https://frontend.example.com"]
+}
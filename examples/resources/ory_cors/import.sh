@@ -0,0 +1,2 @@
+# CORS settings can be imported by specifying this string identifier.
+terraform import ory_cors.example "cors_settings"
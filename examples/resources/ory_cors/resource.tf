@@ -0,0 +1,13 @@
+resource "ory_cors" "example" {
+  public = {
+    enabled = true
+    origins = [
+      "https://app.example.com",
+      "https://*.preview.example.com",
+    ]
+  }
+
+  admin = {
+    enabled = false
+  }
+}
@@ -0,0 +1,2 @@
+# Custom domains can be imported by specifying their ID.
+terraform import ory_custom_domain.example "00000000-0000-0000-0000-000000000000"
@@ -0,0 +1,19 @@
+resource "cloudflare_record" "auth" {
+  zone_id = var.cloudflare_zone_id
+  name    = "auth"
+  type    = "CNAME"
+  content = "${var.ory_project_slug}.projects.oryapis.com"
+}
+
+resource "ory_custom_domain" "example" {
+  hostname      = cloudflare_record.auth.hostname
+  cookie_domain = "example.com"
+
+  cors_enabled = true
+  cors_allowed_origins = [
+    "https://app.example.com",
+  ]
+
+  wait_for_verification = true
+  verification_timeout  = "15m"
+}
@@ -15,6 +15,10 @@ resource "ory_email_configuration" "smtp" {
     security       = "starttls" # options are starttls, starttls_notrust, cleartext, implicittls, implicittls_notrust
     username       = "username"
     password       = "password"
+
+    server_name = "mail.examplecompany.com" # optional, the TLS server name to verify
+    local_name  = "ory.examplecompany.com"  # optional, the hostname sent with HELO
+    # query_params = { custom = ["value"] } # optional, any other connection URI parameters to preserve
   }
 
   smtp_headers = [
@@ -50,6 +54,9 @@ resource "ory_email_configuration" "http" {
     }
 
     action_body = base64encode(file("./somefile.jsonnet")) # base64 encoded string containing the jsonnet body (uses default payload from ory docs if not provided)
+    # action_body_jsonnet        = file("./somefile.jsonnet")        # alternatively, raw jsonnet that is evaluated at plan time
+    # action_body_url            = "https://example.com/body.jsonnet" # alternatively, an https:// jsonnet body passed to Ory as-is, or a file:// body sent inline
+    # action_body_url_fetch_hash = true                               # opt in to fetching the remote body to expose its sha256
   }
 
   smtp_headers = [
@@ -63,3 +70,25 @@ resource "ory_email_configuration" "http" {
     }
   ]
 }
+
+# Email configuration keeping the SMTP password out of the Terraform state (requires Terraform 1.11+)
+variable "smtp_password" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+resource "ory_email_configuration" "smtp_write_only" {
+  server_type = "smtp"
+
+  smtp_config = {
+    sender_name         = "Ory"
+    sender_address      = "noreply@examplecompany.com"
+    host                = "smtp.examplecompany.com"
+    port                = "587"
+    security            = "starttls"
+    username            = "username"
+    password_wo         = var.smtp_password # accepts ephemeral values and is never stored in state
+    password_wo_version = 1                 # increment to push a new password_wo to Ory
+  }
+}
@@ -0,0 +1,2 @@
+# Event streams can be imported by specifying their ID.
+terraform import ory_event_stream.audit "00000000-0000-0000-0000-000000000000"
@@ -0,0 +1,4 @@
+resource "ory_event_stream" "audit" {
+  topic_arn = aws_sns_topic.ory_events.arn
+  role_arn  = aws_iam_role.ory_event_publisher.arn
+}
@@ -0,0 +1,2 @@
+# Identities can be imported by specifying their ID. The password hash is not available after import.
+terraform import ory_identity.break_glass_admin "00000000-0000-0000-0000-000000000000"
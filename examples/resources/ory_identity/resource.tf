@@ -0,0 +1,22 @@
+resource "ory_identity" "break_glass_admin" {
+  schema_id = "preset://email"
+
+  traits = jsonencode({
+    email = "admin@example.com"
+  })
+
+  metadata_admin = jsonencode({
+    role = "break-glass"
+  })
+
+  verifiable_addresses = [
+    {
+      value    = "admin@example.com"
+      via      = "email"
+      verified = true
+    },
+  ]
+
+  # For example a bcrypt hash created with `htpasswd -bnBC 12 "" password | tr -d ':\n'`
+  password_hash = var.admin_password_hash
+}
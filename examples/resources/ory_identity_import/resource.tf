@@ -0,0 +1,16 @@
+# JSONL source, one identity per line:
+# {"traits": {"email": "ada@example.com"}, "password_hash": "$2a$10$..."}
+resource "ory_identity_import" "legacy_users" {
+  source = file("${path.module}/legacy-users.jsonl")
+}
+
+# CSV source with a header row:
+# email,name.first,password_hash
+# ada@example.com,Ada,$argon2id$...
+resource "ory_identity_import" "legacy_customers" {
+  source      = file("${path.module}/legacy-customers.csv")
+  format      = "csv"
+  schema_id   = "customer"
+  batch_size  = 1000
+  concurrency = 2
+}
@@ -0,0 +1,2 @@
+# JSON Web Key Sets can be imported by specifying their set ID.
+terraform import ory_json_web_key_set.session_tokens "session-tokens"
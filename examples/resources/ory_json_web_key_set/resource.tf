@@ -0,0 +1,23 @@
+# Keys generated by Ory
+resource "ory_json_web_key_set" "session_tokens" {
+  set_id = "session-tokens"
+
+  keys = [
+    {
+      kid = "session-tokens-2024"
+      alg = "ES256"
+    },
+  ]
+}
+
+# Imported private key, only the public key is kept in state
+resource "ory_json_web_key_set" "service_tokens" {
+  set_id = "service-tokens"
+
+  keys = [
+    {
+      kid            = "service-tokens-1"
+      private_jwk_wo = file("${path.module}/service-tokens-1.jwk.json")
+    },
+  ]
+}
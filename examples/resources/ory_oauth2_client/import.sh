@@ -0,0 +1,2 @@
+# OAuth2 clients can be imported by specifying their client ID. The client secret is not available after import.
+terraform import ory_oauth2_client.example "00000000-0000-0000-0000-000000000000"
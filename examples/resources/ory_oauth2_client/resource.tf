@@ -0,0 +1,27 @@
+resource "ory_oauth2_client" "example" {
+  client_name    = "Example web app"
+  grant_types    = ["authorization_code", "refresh_token"]
+  response_types = ["code"]
+  scope          = "openid offline_access"
+
+  redirect_uris = [
+    "https://app.example.com/callback",
+  ]
+
+  token_endpoint_auth_method = "client_secret_post"
+  access_token_strategy      = "jwt"
+
+  lifespans = {
+    authorization_code_grant_access_token  = "1h"
+    authorization_code_grant_refresh_token = "720h"
+  }
+
+  metadata = jsonencode({
+    team = "web"
+  })
+}
+
+output "example_client_secret" {
+  value     = ory_oauth2_client.example.client_secret
+  sensitive = true
+}
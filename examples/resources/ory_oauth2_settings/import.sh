@@ -0,0 +1,2 @@
+# OAuth2 settings can be imported by specifying this string identifier.
+terraform import ory_oauth2_settings.example "oauth2_settings"
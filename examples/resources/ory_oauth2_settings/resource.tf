@@ -0,0 +1,17 @@
+resource "ory_oauth2_settings" "example" {
+  issuer_url  = "https://auth.example.com"
+  login_url   = "https://app.example.com/login"
+  consent_url = "https://app.example.com/consent"
+  logout_url  = "https://app.example.com/logout"
+
+  access_token_lifespan  = "1h"
+  refresh_token_lifespan = "720h"
+  access_token_strategy  = "jwt"
+
+  token_hook_url = "https://api.example.com/hooks/token"
+
+  jwt_bearer_grant = {
+    jti_optional = false
+    max_ttl      = "720h"
+  }
+}
@@ -0,0 +1,2 @@
+# Organizations can be imported by specifying their ID.
+terraform import ory_organization.acme "00000000-0000-0000-0000-000000000000"
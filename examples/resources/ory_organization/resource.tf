@@ -0,0 +1,4 @@
+resource "ory_organization" "acme" {
+  label   = "Acme Corp"
+  domains = ["acme.com", "acme.co.uk"]
+}
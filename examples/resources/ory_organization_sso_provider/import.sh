@@ -0,0 +1,2 @@
+# Organization SSO providers can be imported by specifying their method and provider ID.
+terraform import ory_organization_sso_provider.acme_entra "oidc:acme-entra"
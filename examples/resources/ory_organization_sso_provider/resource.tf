@@ -0,0 +1,21 @@
+resource "ory_organization_sso_provider" "acme_entra" {
+  organization_id = ory_organization.acme.id
+  provider_id     = "acme-entra"
+  provider_type   = "microsoft"
+  label           = "Sign in with Acme"
+
+  client_id     = var.acme_client_id
+  client_secret = var.acme_client_secret
+  scope         = ["openid", "email", "profile"]
+
+  mapper_url = "base64://${base64encode(file("${path.module}/acme.jsonnet"))}"
+}
+
+resource "ory_organization_sso_provider" "acme_saml" {
+  organization_id  = ory_organization.acme.id
+  method           = "saml"
+  provider_id      = "acme-saml"
+  idp_metadata_url = "https://idp.acme.com/saml/metadata.xml"
+
+  mapper_url = "base64://${base64encode(file("${path.module}/acme-saml.jsonnet"))}"
+}
@@ -0,0 +1,2 @@
+# Permission namespaces can be imported by specifying this string identifier.
+terraform import ory_permission_namespaces.example "permission_namespaces"
@@ -0,0 +1,3 @@
+resource "ory_permission_namespaces" "example" {
+  opl = file("${path.module}/namespaces.ts")
+}
@@ -0,0 +1,2 @@
+# Project API keys can be imported by specifying their ID. The value of imported keys is not available.
+terraform import ory_project_api_key.ci "00000000-0000-0000-0000-000000000000"
@@ -0,0 +1,14 @@
+# Changing the name creates a new key before the old one is deleted.
+resource "ory_project_api_key" "ci" {
+  name       = "ci-2026-q4"
+  expires_at = "2027-01-15T00:00:00Z"
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+
+output "ci_api_key" {
+  value     = ory_project_api_key.ci.value
+  sensitive = true
+}
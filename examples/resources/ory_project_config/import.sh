@@ -0,0 +1,3 @@
+# The project configuration can be imported by specifying this string identifier.
+# The imported state covers the whole identity configuration.
+terraform import ory_project_config.example "project_config"
@@ -0,0 +1,18 @@
+# Manage the identity configuration exported with `ory get identity-config`
+resource "ory_project_config" "example" {
+  config = file("${path.module}/identity-config.yaml")
+}
+
+# Or build the document in Terraform
+resource "ory_project_config" "inline" {
+  config = jsonencode({
+    selfservice = {
+      flows = {
+        recovery = {
+          enabled = true
+          use     = "code"
+        }
+      }
+    }
+  })
+}
@@ -0,0 +1,2 @@
+# Configuration paths can be imported by specifying the JSON pointer.
+terraform import ory_project_config_path.example "/services/identity/config/selfservice/flows/settings/privileged_session_max_age"
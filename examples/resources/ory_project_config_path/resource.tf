@@ -0,0 +1,14 @@
+# Manage a single configuration value
+resource "ory_project_config_path" "privileged_session_max_age" {
+  path  = "/services/identity/config/selfservice/flows/settings/privileged_session_max_age"
+  value = jsonencode("15m0s")
+}
+
+# Manage a whole subtree of the configuration
+resource "ory_project_config_path" "recovery" {
+  path = "/services/identity/config/selfservice/flows/recovery"
+  value = jsonencode({
+    enabled = true
+    use     = "code"
+  })
+}
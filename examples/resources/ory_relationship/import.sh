@@ -0,0 +1,2 @@
+# Relationships can be imported by specifying them in the notation namespace:object#relation@subject.
+terraform import ory_relationship.admin "Group:admins#members@00000000-0000-0000-0000-000000000000"
@@ -0,0 +1,18 @@
+resource "ory_relationship" "admin" {
+  namespace  = "Group"
+  object     = "admins"
+  relation   = "members"
+  subject_id = "00000000-0000-0000-0000-000000000000"
+}
+
+resource "ory_relationship" "admins_manage_settings" {
+  namespace = "Settings"
+  object    = "project"
+  relation  = "editors"
+
+  subject_set = {
+    namespace = "Group"
+    object    = "admins"
+    relation  = "members"
+  }
+}
@@ -0,0 +1,10 @@
+resource "ory_relationships" "service_accounts" {
+  relationships = [
+    for account in var.service_accounts : {
+      namespace  = "Group"
+      object     = "service-accounts"
+      relation   = "members"
+      subject_id = account
+    }
+  ]
+}
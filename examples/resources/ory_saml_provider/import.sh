@@ -0,0 +1,2 @@
+# SAML providers can be imported by specifying their provider ID.
+terraform import ory_saml_provider.acme "acme-saml"
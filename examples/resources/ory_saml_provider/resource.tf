@@ -0,0 +1,9 @@
+resource "ory_saml_provider" "acme" {
+  provider_id      = "acme-saml"
+  label            = "Sign in with Acme"
+  idp_metadata_xml = file("${path.module}/acme-idp-metadata.xml")
+  entity_id        = "https://idp.acme.com/saml"
+  organization_id  = ory_organization.acme.id
+
+  mapper_url = "base64://${base64encode(file("${path.module}/acme-saml.jsonnet"))}"
+}
@@ -0,0 +1,2 @@
+# Session settings can be imported by specifying this string identifier.
+terraform import ory_session_settings.example "session_settings"
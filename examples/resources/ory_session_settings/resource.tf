@@ -0,0 +1,20 @@
+resource "ory_session_settings" "example" {
+  lifespan                 = "72h"
+  earliest_possible_extend = "24h"
+
+  cookie = {
+    name       = "session"
+    domain     = "example.com"
+    same_site  = "Lax"
+    persistent = true
+    path       = "/"
+  }
+
+  tokenizer_templates = {
+    jwt_example = {
+      ttl               = "1m"
+      claims_mapper_url = "base64://${base64encode(file("${path.module}/claims.jsonnet"))}"
+      jwks_url          = "base64://${base64encode(file("${path.module}/jwks.json"))}"
+    }
+  }
+}
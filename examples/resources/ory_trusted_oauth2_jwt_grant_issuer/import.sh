@@ -0,0 +1,3 @@
+# Trusted JWT grant issuers can be imported by specifying the ID of the trust relationship.
+# The key isn't returned by the API, so it is taken from the configuration on the next apply.
+terraform import ory_trusted_oauth2_jwt_grant_issuer.billing "00000000-0000-0000-0000-000000000000"
@@ -0,0 +1,7 @@
+resource "ory_trusted_oauth2_jwt_grant_issuer" "billing" {
+  issuer     = "https://billing.example.com"
+  subject    = "billing-service"
+  scopes     = ["invoices.read", "invoices.write"]
+  expires_at = "2030-01-01T00:00:00Z"
+  jwk        = file("${path.module}/billing-service.public.jwk.json")
+}
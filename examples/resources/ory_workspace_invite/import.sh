@@ -0,0 +1,2 @@
+# Workspace invites can be imported by specifying their ID.
+terraform import ory_workspace_invite.jane "00000000-0000-0000-0000-000000000000"
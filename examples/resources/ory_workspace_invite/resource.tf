@@ -0,0 +1,4 @@
+resource "ory_workspace_invite" "jane" {
+  email = "jane@example.com"
+  role  = "developer"
+}
@@ -0,0 +1,2 @@
+# Workspace members can be imported by specifying their user ID.
+terraform import ory_workspace_member.jane "00000000-0000-0000-0000-000000000000"
@@ -0,0 +1,5 @@
+# Apply once the invite has been accepted. Removing the resource offboards the member.
+resource "ory_workspace_member" "jane" {
+  email = ory_workspace_invite.jane.email
+  role  = "owner"
+}
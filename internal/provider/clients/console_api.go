@@ -0,0 +1,22 @@
+package oryclient
+
+import (
+	"fmt"
+
+	"github.com/ory/client-go"
+)
+
+// NewConsoleAPIClient returns a client for the console APIs on the given host,
+// such as the organization, project API key and event stream APIs.
+func NewConsoleAPIClient(host, apiKey string) *client.APIClient {
+	configuration := client.NewConfiguration()
+	configuration.Servers = client.ServerConfigurations{
+		{
+			URL: fmt.Sprintf("https://%s", host),
+		},
+	}
+	configuration.AddDefaultHeader("Authorization", "Bearer "+apiKey)
+	configuration.HTTPClient = newRetryingHTTPClient()
+
+	return client.NewAPIClient(configuration)
+}
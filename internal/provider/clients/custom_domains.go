@@ -0,0 +1,72 @@
+package oryclient
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/ory/client-go"
+)
+
+// customDomainsPath is the console endpoint managing the custom domains of a
+// project. The published client only ships its models, the endpoint follows
+// the layout of the neighbouring event stream endpoints.
+const customDomainsPath = "/projects/%s/custom-domains"
+
+func (c *Client) ListCustomDomains() ([]client.CustomDomain, error) {
+	var domains []client.CustomDomain
+
+	err := c.doJSON(http.MethodGet, fmt.Sprintf(customDomainsPath, c.ProjectID), nil, &domains)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list custom domains: %w", err)
+	}
+
+	return domains, nil
+}
+
+// GetCustomDomain returns the custom domain with the given ID, or ErrNotFound.
+func (c *Client) GetCustomDomain(id string) (*client.CustomDomain, error) {
+	domains, err := c.ListCustomDomains()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, domain := range domains {
+		if domain.GetId() == id {
+			return &domain, nil
+		}
+	}
+
+	return nil, ErrNotFound
+}
+
+func (c *Client) CreateCustomDomain(body client.CreateCustomDomainBody) (*client.CustomDomain, error) {
+	var domain client.CustomDomain
+
+	err := c.doJSON(http.MethodPost, fmt.Sprintf(customDomainsPath, c.ProjectID), body, &domain)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create custom domain: %w", err)
+	}
+
+	return &domain, nil
+}
+
+func (c *Client) SetCustomDomain(id string, body client.SetCustomDomainBody) (*client.CustomDomain, error) {
+	var domain client.CustomDomain
+
+	err := c.doJSON(http.MethodPut, fmt.Sprintf(customDomainsPath, c.ProjectID)+"/"+id, body, &domain)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update custom domain: %w", err)
+	}
+
+	return &domain, nil
+}
+
+func (c *Client) DeleteCustomDomain(id string) error {
+	err := c.doJSON(http.MethodDelete, fmt.Sprintf(customDomainsPath, c.ProjectID)+"/"+id, nil, nil)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return fmt.Errorf("failed to delete custom domain: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,98 @@
+package oryclient
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ory/client-go"
+)
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return &Client{
+		BaseURL:    server.URL,
+		APIKey:     "key",
+		ProjectID:  "project",
+		HTTPClient: server.Client(),
+	}
+}
+
+func TestCustomDomains(t *testing.T) {
+	var requests []string
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+
+		if r.Header.Get("Authorization") != "Bearer key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		switch r.Method + " " + r.URL.Path {
+		case "GET /projects/project/custom-domains":
+			_ = json.NewEncoder(w).Encode([]client.CustomDomain{
+				{Id: client.PtrString("a"), Hostname: client.PtrString("a.example.com")},
+				{Id: client.PtrString("b"), Hostname: client.PtrString("b.example.com")},
+			})
+		case "POST /projects/project/custom-domains":
+			var body client.CreateCustomDomainBody
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			_ = json.NewEncoder(w).Encode(client.CustomDomain{Id: client.PtrString("c"), Hostname: body.Hostname})
+		case "DELETE /projects/project/custom-domains/missing":
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error": "bad request"}`))
+		}
+	})
+
+	domain, err := c.GetCustomDomain("b")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if domain.GetHostname() != "b.example.com" {
+		t.Errorf("expected b.example.com, got %q", domain.GetHostname())
+	}
+
+	if _, err := c.GetCustomDomain("missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+
+	created, err := c.CreateCustomDomain(client.CreateCustomDomainBody{Hostname: client.PtrString("c.example.com")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.GetId() != "c" || created.GetHostname() != "c.example.com" {
+		t.Errorf("unexpected custom domain %#v", created)
+	}
+
+	// Deleting a domain that is already gone succeeds
+	if err := c.DeleteCustomDomain("missing"); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+
+	if _, err := c.SetCustomDomain("a", client.SetCustomDomainBody{}); err == nil {
+		t.Error("expected an error for an unexpected status")
+	}
+
+	expected := []string{
+		"GET /projects/project/custom-domains",
+		"GET /projects/project/custom-domains",
+		"POST /projects/project/custom-domains",
+		"DELETE /projects/project/custom-domains/missing",
+		"PUT /projects/project/custom-domains/a",
+	}
+	if len(requests) != len(expected) {
+		t.Fatalf("expected requests %v, got %v", expected, requests)
+	}
+	for i := range expected {
+		if requests[i] != expected[i] {
+			t.Errorf("request %d: expected %q, got %q", i, expected[i], requests[i])
+		}
+	}
+}
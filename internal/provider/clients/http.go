@@ -0,0 +1,59 @@
+package oryclient
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// newRetryingHTTPClient returns the HTTP client shared by the console and
+// project API clients. Idempotent requests are retried on connection errors,
+// rate limits and server errors with exponential backoff, honouring the
+// Retry-After header. Other requests may already have been processed when
+// they fail, so they are only retried when rate limited.
+func newRetryingHTTPClient() *http.Client {
+	return &http.Client{
+		Transport: &retryTransport{
+			idempotent:    newRetryRoundTripper(retryablehttp.DefaultRetryPolicy),
+			nonIdempotent: newRetryRoundTripper(retryRateLimited),
+		},
+	}
+}
+
+func newRetryRoundTripper(checkRetry retryablehttp.CheckRetry) http.RoundTripper {
+	retryClient := retryablehttp.NewClient()
+	retryClient.RetryMax = 4
+	retryClient.Logger = nil
+	retryClient.CheckRetry = checkRetry
+	// Return the last response instead of a generic error so the API error body
+	// is still available to the callers.
+	retryClient.ErrorHandler = retryablehttp.PassthroughErrorHandler
+
+	return &retryablehttp.RoundTripper{Client: retryClient}
+}
+
+// retryRateLimited only retries requests rejected with 429 Too Many Requests,
+// which the API didn't process.
+func retryRateLimited(ctx context.Context, resp *http.Response, err error) (bool, error) {
+	if ctx.Err() != nil {
+		return false, ctx.Err()
+	}
+
+	return err == nil && resp != nil && resp.StatusCode == http.StatusTooManyRequests, nil
+}
+
+// retryTransport picks the retry policy by the idempotency of the request method.
+type retryTransport struct {
+	idempotent    http.RoundTripper
+	nonIdempotent http.RoundTripper
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return t.idempotent.RoundTrip(req)
+	default:
+		return t.nonIdempotent.RoundTrip(req)
+	}
+}
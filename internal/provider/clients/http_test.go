@@ -0,0 +1,54 @@
+package oryclient
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestRetryingHTTPClient(t *testing.T) {
+	cases := []struct {
+		name     string
+		method   string
+		status   int
+		expected int
+	}{
+		{name: "get is retried on server errors", method: http.MethodGet, status: http.StatusServiceUnavailable, expected: 5},
+		{name: "post is not retried on server errors", method: http.MethodPost, status: http.StatusServiceUnavailable, expected: 1},
+		{name: "patch is not retried on server errors", method: http.MethodPatch, status: http.StatusBadGateway, expected: 1},
+		{name: "post is retried when rate limited", method: http.MethodPost, status: http.StatusTooManyRequests, expected: 5},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			attempts := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				attempts++
+				// Skip the backoff between attempts
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(c.status)
+			}))
+			defer server.Close()
+
+			req, err := http.NewRequest(c.method, server.URL, strings.NewReader("{}"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			resp, err := newRetryingHTTPClient().Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != c.status {
+				t.Errorf("expected status %d, got %d", c.status, resp.StatusCode)
+			}
+
+			if attempts != c.expected {
+				t.Errorf("expected %d attempts, got %d", c.expected, attempts)
+			}
+		})
+	}
+}
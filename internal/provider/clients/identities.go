@@ -0,0 +1,48 @@
+package oryclient
+
+import (
+	"context"
+
+	"github.com/kibblator/terraform-provider-ory/internal/provider/helpers"
+	"github.com/ory/client-go"
+)
+
+const identitiesPageSize = 500
+
+// IdentitiesFilter narrows down the identities returned by ListAllIdentities.
+type IdentitiesFilter struct {
+	CredentialsIdentifier string
+	IDs                   []string
+}
+
+// ListAllIdentities returns all identities matching the filter, following the
+// pagination links returned by the API.
+func ListAllIdentities(ctx context.Context, apiClient *client.APIClient, filter IdentitiesFilter) ([]client.Identity, error) {
+	identities := []client.Identity{}
+	pageToken := ""
+
+	for {
+		request := apiClient.IdentityAPI.ListIdentities(ctx).PageSize(identitiesPageSize)
+		if pageToken != "" {
+			request = request.PageToken(pageToken)
+		}
+		if filter.CredentialsIdentifier != "" {
+			request = request.CredentialsIdentifier(filter.CredentialsIdentifier)
+		}
+		if len(filter.IDs) > 0 {
+			request = request.Ids(filter.IDs)
+		}
+
+		page, httpResp, err := request.Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		identities = append(identities, page...)
+
+		pageToken = helpers.NextPageToken(httpResp.Header.Get("Link"))
+		if pageToken == "" || len(page) == 0 {
+			return identities, nil
+		}
+	}
+}
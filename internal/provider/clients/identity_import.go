@@ -0,0 +1,170 @@
+package oryclient
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/kibblator/terraform-provider-ory/internal/provider/helpers"
+	"github.com/ory/client-go"
+)
+
+// IdentityImportResult holds the outcome of an identity import.
+type IdentityImportResult struct {
+	// IdentityIDs maps the identifier of every imported or existing identity to its ID.
+	IdentityIDs map[string]string
+	Imported    int
+	Skipped     int
+	Failures    []IdentityImportFailure
+}
+
+// IdentityImportFailure is a row that could not be imported.
+type IdentityImportFailure struct {
+	Line       int
+	Identifier string
+	Err        error
+}
+
+func (f IdentityImportFailure) Error() string {
+	return fmt.Sprintf("line %d (%s): %s", f.Line, f.Identifier, f.Err)
+}
+
+// ImportIdentities creates the identities of the rows that don't exist yet,
+// matched by their credential identifier, using the batch endpoint. Batches
+// run with the given concurrency.
+func ImportIdentities(ctx context.Context, apiClient *client.APIClient, rows []helpers.IdentityImportRow, batchSize, concurrency int) IdentityImportResult {
+	result := IdentityImportResult{IdentityIDs: map[string]string{}}
+
+	var mutex sync.Mutex
+	record := func(row helpers.IdentityImportRow, id string, err error, imported bool) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		switch {
+		case err != nil:
+			result.Failures = append(result.Failures, IdentityImportFailure{Line: row.Line, Identifier: row.Identifier, Err: err})
+		case imported:
+			result.Imported++
+			result.IdentityIDs[row.Identifier] = id
+		default:
+			result.Skipped++
+			result.IdentityIDs[row.Identifier] = id
+		}
+	}
+
+	// Look up the identities that already exist with a single paged listing,
+	// instead of one lookup per row
+	existing, err := ListAllIdentities(ctx, apiClient, IdentitiesFilter{})
+
+	if err != nil {
+		for _, row := range rows {
+			record(row, "", fmt.Errorf("could not look up the existing identities: %s", ErrorDetail(err)), false)
+		}
+		return result
+	}
+
+	existingIDs := identifierIDs(existing)
+
+	pending := []helpers.IdentityImportRow{}
+	for _, row := range rows {
+		if id, ok := existingIDs[normalizeIdentifier(row.Identifier)]; ok {
+			record(row, id, nil, false)
+			continue
+		}
+
+		pending = append(pending, row)
+	}
+
+	var batches [][]helpers.IdentityImportRow
+	for start := 0; start < len(pending); start += batchSize {
+		end := start + batchSize
+		if end > len(pending) {
+			end = len(pending)
+		}
+		batches = append(batches, pending[start:end])
+	}
+
+	runConcurrently(len(batches), concurrency, func(i int) {
+		batch := batches[i]
+
+		patches := make([]client.IdentityPatch, 0, len(batch))
+		for j := range batch {
+			patches = append(patches, client.IdentityPatch{Create: &batch[j].Identity})
+		}
+
+		response, _, err := apiClient.IdentityAPI.BatchPatchIdentities(ctx).PatchIdentitiesBody(client.PatchIdentitiesBody{Identities: patches}).Execute()
+
+		if err != nil {
+			for _, row := range batch {
+				record(row, "", fmt.Errorf("could not import the batch: %s", ErrorDetail(err)), true)
+			}
+			return
+		}
+
+		// Responses are returned in the order of the patches
+		for j, row := range batch {
+			if j >= len(response.Identities) {
+				record(row, "", fmt.Errorf("missing from the batch response"), true)
+				continue
+			}
+
+			identity := response.Identities[j]
+			if identity.GetAction() == "error" || identity.Error != nil {
+				record(row, "", fmt.Errorf("%v", identity.Error), true)
+				continue
+			}
+
+			record(row, identity.GetIdentity(), nil, true)
+		}
+	})
+
+	sort.Slice(result.Failures, func(i, j int) bool {
+		return result.Failures[i].Line < result.Failures[j].Line
+	})
+
+	return result
+}
+
+// identifierIDs maps the credential identifiers of the identities to their ID.
+func identifierIDs(identities []client.Identity) map[string]string {
+	ids := map[string]string{}
+
+	for _, identity := range identities {
+		for _, credentials := range identity.GetCredentials() {
+			for _, identifier := range credentials.Identifiers {
+				ids[normalizeIdentifier(identifier)] = identity.Id
+			}
+		}
+	}
+
+	return ids
+}
+
+// normalizeIdentifier normalizes an identifier the way Ory stores credential
+// identifiers, which are matched case-insensitively.
+func normalizeIdentifier(identifier string) string {
+	return strings.ToLower(strings.TrimSpace(identifier))
+}
+
+// runConcurrently calls fn for every index below count, running at most
+// concurrency calls at the same time.
+func runConcurrently(count, concurrency int, fn func(i int)) {
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, concurrency)
+
+	for i := 0; i < count; i++ {
+		wg.Add(1)
+		semaphore <- struct{}{}
+
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			fn(i)
+		}(i)
+	}
+
+	wg.Wait()
+}
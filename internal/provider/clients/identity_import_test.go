@@ -0,0 +1,74 @@
+package oryclient
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/kibblator/terraform-provider-ory/internal/provider/helpers"
+	"github.com/ory/client-go"
+)
+
+func TestImportIdentities(t *testing.T) {
+	lists := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.Method + " " + r.URL.Path {
+		case "GET /admin/identities":
+			lists++
+			_ = json.NewEncoder(w).Encode([]client.Identity{{
+				Id:          "existing",
+				Traits:      map[string]interface{}{"email": "ada@example.com"},
+				Credentials: &map[string]client.IdentityCredentials{"password": {Identifiers: []string{"ada@example.com"}}},
+			}})
+		case "PATCH /admin/identities":
+			var body client.PatchIdentitiesBody
+			_ = json.NewDecoder(r.Body).Decode(&body)
+
+			response := client.BatchPatchIdentitiesResponse{}
+			for i := range body.Identities {
+				if i == 1 {
+					response.Identities = append(response.Identities, client.IdentityPatchResponse{Action: client.PtrString("error"), Error: map[string]interface{}{"message": "conflict"}})
+					continue
+				}
+				response.Identities = append(response.Identities, client.IdentityPatchResponse{Action: client.PtrString("create"), Identity: client.PtrString("created")})
+			}
+			_ = json.NewEncoder(w).Encode(response)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	configuration := client.NewConfiguration()
+	configuration.Servers = client.ServerConfigurations{{URL: server.URL}}
+	configuration.HTTPClient = server.Client()
+
+	rows := []helpers.IdentityImportRow{
+		{Line: 1, Identifier: "Ada@example.com"},
+		{Line: 2, Identifier: "grace@example.com"},
+		{Line: 3, Identifier: "linus@example.com"},
+	}
+
+	result := ImportIdentities(context.Background(), client.NewAPIClient(configuration), rows, 10, 2)
+
+	if lists != 1 {
+		t.Errorf("expected a single lookup of the existing identities, got %d", lists)
+	}
+	if result.Skipped != 1 || result.IdentityIDs["Ada@example.com"] != "existing" {
+		t.Errorf("expected Ada@example.com to be skipped, got %#v", result)
+	}
+	if result.Imported != 1 || result.IdentityIDs["grace@example.com"] != "created" {
+		t.Errorf("expected grace@example.com to be imported, got %#v", result)
+	}
+	if len(result.Failures) != 1 || result.Failures[0].Line != 3 {
+		t.Errorf("expected line 3 to fail, got %v", result.Failures)
+	}
+	if _, ok := result.IdentityIDs["linus@example.com"]; ok {
+		t.Error("expected the failed row to have no identity ID")
+	}
+}
@@ -3,6 +3,7 @@ package oryclient
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -12,6 +13,9 @@ import (
 	"github.com/ory/client-go"
 )
 
+// ErrNotFound is returned when the requested object doesn't exist (anymore).
+var ErrNotFound = errors.New("not found")
+
 type Client struct {
 	BaseURL    string
 	APIKey     string
@@ -20,10 +24,12 @@ type Client struct {
 }
 
 type OryClient struct {
-	APIClient     *Client
-	ProjectConfig *orytypes.Project
-	ProjectID     string
-	Mutex         sync.Mutex
+	APIClient        *Client
+	ConsoleAPIClient *client.APIClient
+	ProjectAPIClient *client.APIClient
+	ProjectConfig    *orytypes.Project
+	ProjectID        string
+	Mutex            sync.Mutex
 }
 
 func NewClient(baseUrl, apiKey, projectID string) *Client {
@@ -31,11 +37,43 @@ func NewClient(baseUrl, apiKey, projectID string) *Client {
 		BaseURL:    fmt.Sprintf("https://%s", baseUrl),
 		APIKey:     apiKey,
 		ProjectID:  projectID,
-		HTTPClient: &http.Client{},
+		HTTPClient: newRetryingHTTPClient(),
 	}
 }
 
 func (c *Client) GetProject(m *sync.Mutex) (*orytypes.Project, error) {
+	body, err := c.getProjectBody(m)
+	if err != nil {
+		return nil, err
+	}
+
+	var config orytypes.Project
+	err = json.Unmarshal(body, &config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &config, nil
+}
+
+// GetProjectDocument returns the project as an untyped JSON document, including
+// configuration that is not modelled in orytypes.
+func (c *Client) GetProjectDocument(m *sync.Mutex) (map[string]interface{}, error) {
+	body, err := c.getProjectBody(m)
+	if err != nil {
+		return nil, err
+	}
+
+	var document map[string]interface{}
+	err = json.Unmarshal(body, &document)
+	if err != nil {
+		return nil, err
+	}
+
+	return document, nil
+}
+
+func (c *Client) getProjectBody(m *sync.Mutex) ([]byte, error) {
 	m.Lock()
 	defer m.Unlock()
 
@@ -57,18 +95,7 @@ func (c *Client) GetProject(m *sync.Mutex) (*orytypes.Project, error) {
 		return nil, fmt.Errorf("failed to fetch project: %s", body)
 	}
 
-	var config orytypes.Project
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-
-	err = json.Unmarshal(body, &config)
-	if err != nil {
-		return nil, err
-	}
-
-	return &config, nil
+	return io.ReadAll(resp.Body)
 }
 
 func (c *Client) PatchProject(revisionID string, patchData []client.JsonPatch, m *sync.Mutex) (*orytypes.ProjectConfig, error) {
@@ -112,3 +139,47 @@ func (c *Client) PatchProject(revisionID string, patchData []client.JsonPatch, m
 
 	return &updatedConfig, nil
 }
+
+// doJSON sends a console API request with an optional JSON body and decodes
+// the JSON response into out, when given.
+func (c *Client) doJSON(method, path string, body interface{}, out interface{}) error {
+	var reader io.Reader
+
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewBuffer(data)
+	}
+
+	req, err := http.NewRequest(method, c.BaseURL+path, reader)
+	if err != nil {
+		return err
+	}
+	req.Header.Add("Authorization", "Bearer "+c.APIKey)
+	if body != nil {
+		req.Header.Add("Content-Type", "application/json")
+	}
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return ErrNotFound
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		respBody, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("unexpected status %d: %s", resp.StatusCode, respBody)
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	return json.NewDecoder(resp.Body).Decode(out)
+}
@@ -0,0 +1,34 @@
+package oryclient
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ory/client-go"
+)
+
+// NewProjectAPIClient returns a client for the admin APIs of the project
+// served on the given host, such as the OAuth2 and identity APIs.
+func NewProjectAPIClient(host, apiKey string) *client.APIClient {
+	configuration := client.NewConfiguration()
+	configuration.Servers = client.ServerConfigurations{
+		{
+			URL: fmt.Sprintf("https://%s", host),
+		},
+	}
+	configuration.AddDefaultHeader("Authorization", "Bearer "+apiKey)
+	configuration.HTTPClient = newRetryingHTTPClient()
+
+	return client.NewAPIClient(configuration)
+}
+
+// ErrorDetail returns the error message including the response body returned
+// by the API, which holds the actual reason of the failure.
+func ErrorDetail(err error) string {
+	var apiErr *client.GenericOpenAPIError
+	if errors.As(err, &apiErr) && len(apiErr.Body()) > 0 {
+		return fmt.Sprintf("%s: %s", apiErr.Error(), apiErr.Body())
+	}
+
+	return err.Error()
+}
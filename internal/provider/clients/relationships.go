@@ -0,0 +1,53 @@
+package oryclient
+
+import (
+	"context"
+
+	"github.com/ory/client-go"
+)
+
+// RelationshipExists reports whether the exact relation tuple exists.
+func RelationshipExists(ctx context.Context, apiClient *client.APIClient, relationship client.Relationship) (bool, error) {
+	request := apiClient.RelationshipAPI.GetRelationships(ctx).
+		Namespace(relationship.Namespace).
+		Object(relationship.Object).
+		Relation(relationship.Relation)
+
+	if set := relationship.SubjectSet; set != nil {
+		request = request.
+			SubjectSetNamespace(set.Namespace).
+			SubjectSetObject(set.Object).
+			SubjectSetRelation(set.Relation)
+	} else {
+		request = request.SubjectId(relationship.GetSubjectId())
+	}
+
+	relationships, _, err := request.Execute()
+	if err != nil {
+		return false, err
+	}
+
+	return len(relationships.RelationTuples) > 0, nil
+}
+
+// DeleteRelationship deletes the exact relation tuple. Deleting a missing
+// tuple succeeds.
+func DeleteRelationship(ctx context.Context, apiClient *client.APIClient, relationship client.Relationship) error {
+	request := apiClient.RelationshipAPI.DeleteRelationships(ctx).
+		Namespace(relationship.Namespace).
+		Object(relationship.Object).
+		Relation(relationship.Relation)
+
+	if set := relationship.SubjectSet; set != nil {
+		request = request.
+			SubjectSetNamespace(set.Namespace).
+			SubjectSetObject(set.Object).
+			SubjectSetRelation(set.Relation)
+	} else {
+		request = request.SubjectId(relationship.GetSubjectId())
+	}
+
+	_, err := request.Execute()
+
+	return err
+}
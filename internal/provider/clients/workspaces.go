@@ -0,0 +1,143 @@
+package oryclient
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/ory/client-go"
+)
+
+const (
+	workspaceProjectsPath = "/workspaces/%s/projects"
+
+	// workspaceInvitesPath and workspaceMembersPath are the console endpoints
+	// managing the members of a workspace. The published client only ships
+	// their models, the endpoints follow the layout of the project members.
+	workspaceInvitesPath = "/workspaces/%s/invites"
+	workspaceMembersPath = "/workspaces/%s/members"
+)
+
+// ListWorkspaceProjects returns all projects of the workspace, following the
+// pagination of the API.
+func (c *Client) ListWorkspaceProjects(workspaceID string) ([]client.ProjectMetadata, error) {
+	projects := []client.ProjectMetadata{}
+	pageToken := ""
+
+	for {
+		path := fmt.Sprintf(workspaceProjectsPath, workspaceID)
+		if pageToken != "" {
+			path += "?page_token=" + url.QueryEscape(pageToken)
+		}
+
+		var page client.ListWorkspaceProjects
+
+		err := c.doJSON(http.MethodGet, path, nil, &page)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list workspace projects: %w", err)
+		}
+
+		projects = append(projects, page.Projects...)
+
+		if !page.HasNextPage || page.NextPage == "" || page.NextPage == pageToken {
+			return projects, nil
+		}
+		pageToken = page.NextPage
+	}
+}
+
+func (c *Client) ListWorkspaceInvites(workspaceID string) ([]client.MemberInvite, error) {
+	var invites []client.MemberInvite
+
+	err := c.doJSON(http.MethodGet, fmt.Sprintf(workspaceInvitesPath, workspaceID), nil, &invites)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workspace invites: %w", err)
+	}
+
+	return invites, nil
+}
+
+// GetWorkspaceInvite returns the workspace invite with the given ID, or ErrNotFound.
+func (c *Client) GetWorkspaceInvite(workspaceID, id string) (*client.MemberInvite, error) {
+	invites, err := c.ListWorkspaceInvites(workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, invite := range invites {
+		if invite.Id == id {
+			return &invite, nil
+		}
+	}
+
+	return nil, ErrNotFound
+}
+
+func (c *Client) CreateWorkspaceInvite(workspaceID string, body client.CreateWorkspaceMemberInviteBody) (*client.MemberInvite, error) {
+	var response client.CreateInviteResponse
+
+	err := c.doJSON(http.MethodPost, fmt.Sprintf(workspaceInvitesPath, workspaceID), body, &response)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create workspace invite: %w", err)
+	}
+
+	return &response.CreatedInvite, nil
+}
+
+func (c *Client) DeleteWorkspaceInvite(workspaceID, id string) error {
+	err := c.doJSON(http.MethodDelete, fmt.Sprintf(workspaceInvitesPath, workspaceID)+"/"+id, nil, nil)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return fmt.Errorf("failed to delete workspace invite: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Client) ListWorkspaceMembers(workspaceID string) ([]client.ProjectMember, error) {
+	var members []client.ProjectMember
+
+	err := c.doJSON(http.MethodGet, fmt.Sprintf(workspaceMembersPath, workspaceID), nil, &members)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workspace members: %w", err)
+	}
+
+	return members, nil
+}
+
+// GetWorkspaceMemberByEmail returns the workspace member with the given email
+// address, or ErrNotFound.
+func (c *Client) GetWorkspaceMemberByEmail(workspaceID, email string) (*client.ProjectMember, error) {
+	members, err := c.ListWorkspaceMembers(workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, member := range members {
+		if member.Email == email {
+			return &member, nil
+		}
+	}
+
+	return nil, ErrNotFound
+}
+
+func (c *Client) SetWorkspaceMemberRole(workspaceID, memberID, role string) error {
+	body := map[string]string{"role": role}
+
+	err := c.doJSON(http.MethodPut, fmt.Sprintf(workspaceMembersPath, workspaceID)+"/"+memberID, body, nil)
+	if err != nil {
+		return fmt.Errorf("failed to update workspace member: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Client) RemoveWorkspaceMember(workspaceID, memberID string) error {
+	err := c.doJSON(http.MethodDelete, fmt.Sprintf(workspaceMembersPath, workspaceID)+"/"+memberID, nil, nil)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return fmt.Errorf("failed to remove workspace member: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package oryclient
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/ory/client-go"
+)
+
+func TestListWorkspaceProjects(t *testing.T) {
+	pages := map[string]client.ListWorkspaceProjects{
+		"":       {HasNextPage: true, NextPage: "page-2", Projects: []client.ProjectMetadata{{Id: "a"}, {Id: "b"}}},
+		"page-2": {HasNextPage: false, Projects: []client.ProjectMetadata{{Id: "c"}}},
+	}
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/workspaces/workspace/projects" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		page, ok := pages[r.URL.Query().Get("page_token")]
+		if !ok {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(page)
+	})
+
+	projects, err := c.ListWorkspaceProjects("workspace")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ids := []string{}
+	for _, project := range projects {
+		ids = append(ids, project.Id)
+	}
+
+	if len(ids) != 3 || ids[0] != "a" || ids[1] != "b" || ids[2] != "c" {
+		t.Errorf("expected the projects of both pages, got %v", ids)
+	}
+}
+
+func TestWorkspaceMembers(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /workspaces/workspace/invites":
+			_ = json.NewEncoder(w).Encode([]client.MemberInvite{{Id: "invite", InviteeEmail: "ada@example.com"}})
+		case "GET /workspaces/workspace/members":
+			_ = json.NewEncoder(w).Encode([]client.ProjectMember{{Id: "member", Email: "ada@example.com", Role: "developer"}})
+		case "PUT /workspaces/workspace/members/member":
+			var body map[string]string
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil || body["role"] != "owner" {
+				w.WriteHeader(http.StatusBadRequest)
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	invite, err := c.GetWorkspaceInvite("workspace", "invite")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if invite.InviteeEmail != "ada@example.com" {
+		t.Errorf("expected ada@example.com, got %q", invite.InviteeEmail)
+	}
+
+	if _, err := c.GetWorkspaceInvite("workspace", "missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+
+	member, err := c.GetWorkspaceMemberByEmail("workspace", "ada@example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if member.Id != "member" || member.Role != "developer" {
+		t.Errorf("unexpected member %#v", member)
+	}
+
+	if err := c.SetWorkspaceMemberRole("workspace", "member", "owner"); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+
+	// Removing a member that already left succeeds
+	if err := c.RemoveWorkspaceMember("workspace", "gone"); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+}
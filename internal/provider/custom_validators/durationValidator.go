@@ -0,0 +1,43 @@
+package custom_validators
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+type DurationValidator struct{}
+
+func (d DurationValidator) Description(_ context.Context) string {
+	return "Ensures the string is a positive Go duration such as 24h or 1h30m"
+}
+
+func (d DurationValidator) MarkdownDescription(_ context.Context) string {
+	return "Ensures the string is a positive **Go duration** such as `24h` or `1h30m`"
+}
+
+func (d DurationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	duration, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			fmt.Sprintf("The provided string is not a valid duration: %s", err),
+		)
+		return
+	}
+
+	if duration <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			fmt.Sprintf("The duration %q must be positive.", req.ConfigValue.ValueString()),
+		)
+	}
+}
@@ -0,0 +1,44 @@
+package custom_validators
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/jsonpatch"
+)
+
+type JsonPointerValidator struct{}
+
+func (j JsonPointerValidator) Description(_ context.Context) string {
+	return "Ensures the string is a non-empty RFC 6901 JSON pointer"
+}
+
+func (j JsonPointerValidator) MarkdownDescription(_ context.Context) string {
+	return "Ensures the string is a non-empty **RFC 6901 JSON pointer**"
+}
+
+func (j JsonPointerValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+
+	if value == "" {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid JSON Pointer",
+			"The JSON pointer must refer to a value within the project, not the whole project.",
+		)
+		return
+	}
+
+	if _, err := jsonpatch.ParsePointer(value); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid JSON Pointer",
+			fmt.Sprintf("The provided string is not a valid JSON pointer: %s", err),
+		)
+	}
+}
@@ -0,0 +1,36 @@
+package custom_validators
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/helpers"
+)
+
+type JsonnetValidator struct {
+	SampleContext string
+}
+
+func (j JsonnetValidator) Description(_ context.Context) string {
+	return "Ensures the string is Jsonnet that evaluates against a sample ctx payload"
+}
+
+func (j JsonnetValidator) MarkdownDescription(_ context.Context) string {
+	return "Ensures the string is **Jsonnet** that evaluates against a sample `ctx` payload"
+}
+
+func (j JsonnetValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	_, err := helpers.EvaluateJsonnet(req.ConfigValue.ValueString(), j.SampleContext)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Jsonnet",
+			fmt.Sprintf("The provided Jsonnet could not be evaluated against the sample ctx payload: %s", err),
+		)
+	}
+}
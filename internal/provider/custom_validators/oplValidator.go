@@ -0,0 +1,33 @@
+package custom_validators
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/helpers"
+)
+
+type OPLValidator struct{}
+
+func (o OPLValidator) Description(_ context.Context) string {
+	return "Ensures the string is an Ory Permission Language source declaring at least one namespace"
+}
+
+func (o OPLValidator) MarkdownDescription(_ context.Context) string {
+	return "Ensures the string is an **Ory Permission Language** source declaring at least one namespace"
+}
+
+func (o OPLValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := helpers.CheckOPL(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Ory Permission Language",
+			fmt.Sprintf("The provided namespaces could not be checked: %s", err),
+		)
+	}
+}
@@ -0,0 +1,78 @@
+package custom_validators
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+type OriginValidator struct{}
+
+func (o OriginValidator) Description(_ context.Context) string {
+	return "Ensures the string is a CORS origin such as https://example.com, optionally with a single wildcard in the host"
+}
+
+func (o OriginValidator) MarkdownDescription(_ context.Context) string {
+	return "Ensures the string is a **CORS origin** such as `https://example.com`, optionally with a single wildcard in the host"
+}
+
+func (o OriginValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+
+	if value == "*" {
+		return
+	}
+
+	if strings.Count(value, "*") > 1 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Origin",
+			fmt.Sprintf("The origin %q may contain at most one wildcard.", value),
+		)
+		return
+	}
+
+	// The wildcard is not valid in a URL host, so validate with a placeholder
+	parsedURL, err := url.Parse(strings.Replace(value, "*", "wildcard", 1))
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Origin",
+			fmt.Sprintf("The provided string is not a valid origin: %s", err),
+		)
+		return
+	}
+
+	if parsedURL.Scheme != "http" && parsedURL.Scheme != "https" {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Origin",
+			fmt.Sprintf("The origin %q must use the http or https scheme.", value),
+		)
+		return
+	}
+
+	if parsedURL.Host == "" || parsedURL.User != nil || strings.TrimSuffix(parsedURL.Path, "/") != "" || parsedURL.RawQuery != "" || parsedURL.Fragment != "" {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Origin",
+			fmt.Sprintf("The origin %q must only consist of a scheme, host and optional port.", value),
+		)
+		return
+	}
+
+	if strings.Contains(value, "*") && !strings.Contains(strings.SplitN(parsedURL.Host, ":", 2)[0], "wildcard") {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Origin",
+			fmt.Sprintf("The wildcard in origin %q is only allowed in the host.", value),
+		)
+	}
+}
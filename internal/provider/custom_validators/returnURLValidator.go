@@ -0,0 +1,66 @@
+package custom_validators
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+type ReturnURLValidator struct{}
+
+func (r ReturnURLValidator) Description(_ context.Context) string {
+	return "Ensures the string is an absolute URL, optionally containing glob wildcards such as https://*.example.com"
+}
+
+func (r ReturnURLValidator) MarkdownDescription(_ context.Context) string {
+	return "Ensures the string is an **absolute URL**, optionally containing glob wildcards such as `https://*.example.com`"
+}
+
+func (r ReturnURLValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+
+	if _, err := path.Match(value, ""); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Return URL",
+			fmt.Sprintf("The return URL %q is not a valid glob pattern: %s", value, err),
+		)
+		return
+	}
+
+	// Glob wildcards are not valid in a URL, so validate with a placeholder
+	parsedURL, err := url.Parse(strings.ReplaceAll(value, "*", "wildcard"))
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Return URL",
+			fmt.Sprintf("The provided string is not a valid URL: %s", err),
+		)
+		return
+	}
+
+	if parsedURL.Scheme == "" || (parsedURL.Host == "" && parsedURL.Opaque == "" && parsedURL.Path == "") {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Return URL",
+			fmt.Sprintf("The return URL %q must be an absolute URL.", value),
+		)
+		return
+	}
+
+	if (parsedURL.Scheme == "http" || parsedURL.Scheme == "https") && parsedURL.Host == "" {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Return URL",
+			fmt.Sprintf("The return URL %q is missing a host.", value),
+		)
+	}
+}
@@ -0,0 +1,33 @@
+package custom_validators
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+type TimestampValidator struct{}
+
+func (t TimestampValidator) Description(_ context.Context) string {
+	return "Ensures the string is an RFC 3339 timestamp such as 2030-01-01T00:00:00Z"
+}
+
+func (t TimestampValidator) MarkdownDescription(_ context.Context) string {
+	return "Ensures the string is an **RFC 3339** timestamp such as `2030-01-01T00:00:00Z`"
+}
+
+func (t TimestampValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := time.Parse(time.RFC3339, req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Timestamp",
+			fmt.Sprintf("The provided string is not a valid RFC 3339 timestamp: %s", err),
+		)
+	}
+}
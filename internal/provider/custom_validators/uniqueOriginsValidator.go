@@ -0,0 +1,55 @@
+package custom_validators
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// UniqueOriginsValidator ensures no two origins of a list are the same once
+// trailing slashes are ignored, as they are when sent to Ory.
+type UniqueOriginsValidator struct{}
+
+func (u UniqueOriginsValidator) Description(_ context.Context) string {
+	return "Ensures all origins are unique, ignoring trailing slashes"
+}
+
+func (u UniqueOriginsValidator) MarkdownDescription(_ context.Context) string {
+	return "Ensures all origins are **unique**, ignoring trailing slashes"
+}
+
+func (u UniqueOriginsValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	seen := map[string]string{}
+
+	for i, element := range req.ConfigValue.Elements() {
+		origin, ok := element.(types.String)
+		if !ok || origin.IsNull() || origin.IsUnknown() {
+			continue
+		}
+
+		normalized := NormalizeOrigin(origin.ValueString())
+
+		if previous, found := seen[normalized]; found {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtListIndex(i),
+				"Duplicate Origin",
+				fmt.Sprintf("The origin %q is the same as %q, trailing slashes are ignored.", origin.ValueString(), previous),
+			)
+			continue
+		}
+
+		seen[normalized] = origin.ValueString()
+	}
+}
+
+// NormalizeOrigin strips the trailing slash browsers never send in the Origin header.
+func NormalizeOrigin(origin string) string {
+	return strings.TrimSuffix(origin, "/")
+}
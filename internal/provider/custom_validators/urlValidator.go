@@ -0,0 +1,57 @@
+package custom_validators
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+type URLValidator struct {
+	Schemes []string
+}
+
+func (u URLValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("Ensures the string is an absolute URL using one of the schemes: %s", strings.Join(u.Schemes, ", "))
+}
+
+func (u URLValidator) MarkdownDescription(_ context.Context) string {
+	return fmt.Sprintf("Ensures the string is an **absolute URL** using one of the schemes: `%s`", strings.Join(u.Schemes, "`, `"))
+}
+
+func (u URLValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	parsedURL, err := url.Parse(value)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid URL",
+			fmt.Sprintf("The provided string is not a valid URL: %s", err),
+		)
+		return
+	}
+
+	if !slices.Contains(u.Schemes, parsedURL.Scheme) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid URL Scheme",
+			fmt.Sprintf("The URL %q must use one of the schemes: %s", value, strings.Join(u.Schemes, ", ")),
+		)
+		return
+	}
+
+	if parsedURL.Host == "" && parsedURL.Scheme != "file" {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid URL",
+			fmt.Sprintf("The URL %q is missing a host.", value),
+		)
+	}
+}
@@ -0,0 +1,50 @@
+package identities_data_source
+
+import (
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ory/client-go"
+)
+
+// ApiToIdentities maps the identities returned by the API onto the model.
+func ApiToIdentities(identities []client.Identity, tfConfig *identitiesDataSourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tfConfig.Identities = make([]Identity, 0, len(identities))
+
+	for _, identity := range identities {
+		tfIdentity := Identity{
+			ID:             types.StringValue(identity.Id),
+			SchemaID:       types.StringValue(identity.SchemaId),
+			State:          types.StringValue(identity.GetState()),
+			Traits:         jsonToTf(identity.Traits, &diags),
+			MetadataPublic: jsontypes.NewNormalizedNull(),
+			MetadataAdmin:  jsontypes.NewNormalizedNull(),
+		}
+
+		if len(identity.MetadataPublic) > 0 {
+			tfIdentity.MetadataPublic = jsonToTf(identity.MetadataPublic, &diags)
+		}
+
+		if len(identity.MetadataAdmin) > 0 {
+			tfIdentity.MetadataAdmin = jsonToTf(identity.MetadataAdmin, &diags)
+		}
+
+		tfConfig.Identities = append(tfConfig.Identities, tfIdentity)
+	}
+
+	return diags
+}
+
+func jsonToTf(value interface{}, diags *diag.Diagnostics) jsontypes.Normalized {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		diags.AddError("Error encoding ORY identity", "Could not encode the identity: "+err.Error())
+		return jsontypes.NewNormalizedNull()
+	}
+
+	return jsontypes.NewNormalizedValue(string(encoded))
+}
@@ -0,0 +1,172 @@
+package identities_data_source
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	oryclient "github.com/kibblator/terraform-provider-ory/internal/provider/clients"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &identitiesDataSource{}
+	_ datasource.DataSourceWithConfigure = &identitiesDataSource{}
+)
+
+// NewIdentitiesDataSource is a helper function to simplify the provider implementation.
+func NewIdentitiesDataSource() datasource.DataSource {
+	return &identitiesDataSource{}
+}
+
+// identitiesDataSource is the data source implementation.
+type identitiesDataSource struct {
+	oryClient *oryclient.OryClient
+}
+
+type Identity struct {
+	ID             types.String         `tfsdk:"id"`
+	SchemaID       types.String         `tfsdk:"schema_id"`
+	State          types.String         `tfsdk:"state"`
+	Traits         jsontypes.Normalized `tfsdk:"traits"`
+	MetadataPublic jsontypes.Normalized `tfsdk:"metadata_public"`
+	MetadataAdmin  jsontypes.Normalized `tfsdk:"metadata_admin"`
+}
+
+// identitiesDataSourceModel maps the data source schema data.
+type identitiesDataSourceModel struct {
+	CredentialsIdentifier types.String   `tfsdk:"credentials_identifier"`
+	IDs                   []types.String `tfsdk:"ids"`
+	Identities            []Identity     `tfsdk:"identities"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *identitiesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*oryclient.OryClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected OryClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.oryClient = client
+}
+
+// Metadata returns the data source type name.
+func (d *identitiesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_identities"
+}
+
+// Schema defines the schema for the data source.
+func (d *identitiesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the identities of the project, optionally filtered by credentials identifier or IDs. All pages are read.",
+		Attributes: map[string]schema.Attribute{
+			"credentials_identifier": schema.StringAttribute{
+				Description: "Only return the identity with this credentials identifier, for example an email address.",
+				Optional:    true,
+			},
+			"ids": schema.ListAttribute{
+				Description: "Only return the identities with these IDs.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"identities": schema.ListNestedAttribute{
+				Description: "Identities matching the filters.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "ID of the identity.",
+							Computed:    true,
+						},
+						"schema_id": schema.StringAttribute{
+							Description: "ID of the identity schema the traits are validated against.",
+							Computed:    true,
+						},
+						"state": schema.StringAttribute{
+							Description: "State of the identity, either `active` or `inactive`.",
+							Computed:    true,
+						},
+						"traits": schema.StringAttribute{
+							Description: "JSON encoded traits of the identity.",
+							CustomType:  jsontypes.NormalizedType{},
+							Computed:    true,
+						},
+						"metadata_public": schema.StringAttribute{
+							Description: "JSON encoded metadata visible to the identity itself.",
+							CustomType:  jsontypes.NormalizedType{},
+							Computed:    true,
+						},
+						"metadata_admin": schema.StringAttribute{
+							Description: "JSON encoded metadata only visible through the admin API.",
+							CustomType:  jsontypes.NormalizedType{},
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *identitiesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Reading identities data source")
+
+	var config identitiesDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter := oryclient.IdentitiesFilter{
+		CredentialsIdentifier: config.CredentialsIdentifier.ValueString(),
+	}
+	for _, id := range config.IDs {
+		filter.IDs = append(filter.IDs, id.ValueString())
+	}
+
+	identities, err := oryclient.ListAllIdentities(ctx, d.oryClient.ProjectAPIClient, filter)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error fetching ORY identities",
+			"Could not retrieve ORY identities: "+oryclient.ErrorDetail(err),
+		)
+		return
+	}
+
+	diags = ApiToIdentities(identities, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
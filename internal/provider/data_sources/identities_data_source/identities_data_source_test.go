@@ -0,0 +1,47 @@
+package identities_data_source_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kibblator/terraform-provider-ory/internal/provider/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOryIdentitiesDataSource(t *testing.T) {
+	randomName := acctest.GenerateRandomResourceName()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			// Filter by IDs and by credentials identifier
+			{
+				Config: fmt.Sprintf(`
+resource "ory_identity" "%[1]s" {
+  count = 2
+
+  schema_id = "preset://email"
+  traits    = jsonencode({ email = "%[1]s-${count.index}@example.com" })
+}
+
+data "ory_identities" "by_ids" {
+  ids = ory_identity.%[1]s[*].id
+}
+
+data "ory_identities" "by_identifier" {
+  credentials_identifier = "%[1]s-1@example.com"
+
+  depends_on = [ory_identity.%[1]s]
+}
+`, randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ory_identities.by_ids", "identities.#", "2"),
+					resource.TestCheckResourceAttr("data.ory_identities.by_identifier", "identities.#", "1"),
+					resource.TestCheckResourceAttrPair("data.ory_identities.by_identifier", "identities.0.id", fmt.Sprintf("ory_identity.%s.1", randomName), "id"),
+				),
+			},
+		},
+	})
+}
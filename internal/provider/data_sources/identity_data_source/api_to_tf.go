@@ -0,0 +1,42 @@
+package identity_data_source
+
+import (
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ory/client-go"
+)
+
+// ApiToIdentity maps the identity returned by the API onto the model.
+func ApiToIdentity(identity *client.Identity, tfConfig *identityDataSourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tfConfig.ID = types.StringValue(identity.Id)
+	tfConfig.SchemaID = types.StringValue(identity.SchemaId)
+	tfConfig.State = types.StringValue(identity.GetState())
+	tfConfig.Traits = jsonToTf(identity.Traits, &diags)
+	tfConfig.MetadataPublic = jsontypes.NewNormalizedNull()
+	tfConfig.MetadataAdmin = jsontypes.NewNormalizedNull()
+
+	if len(identity.MetadataPublic) > 0 {
+		tfConfig.MetadataPublic = jsonToTf(identity.MetadataPublic, &diags)
+	}
+
+	if len(identity.MetadataAdmin) > 0 {
+		tfConfig.MetadataAdmin = jsonToTf(identity.MetadataAdmin, &diags)
+	}
+
+	return diags
+}
+
+func jsonToTf(value interface{}, diags *diag.Diagnostics) jsontypes.Normalized {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		diags.AddError("Error encoding ORY identity", "Could not encode the identity: "+err.Error())
+		return jsontypes.NewNormalizedNull()
+	}
+
+	return jsontypes.NewNormalizedValue(string(encoded))
+}
@@ -0,0 +1,187 @@
+package identity_data_source
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	oryclient "github.com/kibblator/terraform-provider-ory/internal/provider/clients"
+	"github.com/ory/client-go"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &identityDataSource{}
+	_ datasource.DataSourceWithConfigure = &identityDataSource{}
+)
+
+// NewIdentityDataSource is a helper function to simplify the provider implementation.
+func NewIdentityDataSource() datasource.DataSource {
+	return &identityDataSource{}
+}
+
+// identityDataSource is the data source implementation.
+type identityDataSource struct {
+	oryClient *oryclient.OryClient
+}
+
+// identityDataSourceModel maps the data source schema data.
+type identityDataSourceModel struct {
+	ID                    types.String         `tfsdk:"id"`
+	CredentialsIdentifier types.String         `tfsdk:"credentials_identifier"`
+	SchemaID              types.String         `tfsdk:"schema_id"`
+	State                 types.String         `tfsdk:"state"`
+	Traits                jsontypes.Normalized `tfsdk:"traits"`
+	MetadataPublic        jsontypes.Normalized `tfsdk:"metadata_public"`
+	MetadataAdmin         jsontypes.Normalized `tfsdk:"metadata_admin"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *identityDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*oryclient.OryClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected OryClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.oryClient = client
+}
+
+// Metadata returns the data source type name.
+func (d *identityDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_identity"
+}
+
+// Schema defines the schema for the data source.
+func (d *identityDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a single identity of the project, either by its ID or by a credentials identifier such as an email address.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the identity. Exactly one of `id` and `credentials_identifier` must be set.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("credentials_identifier")),
+				},
+			},
+			"credentials_identifier": schema.StringAttribute{
+				Description: "Identifier of one of the credentials of the identity, for example the email address used to sign in.",
+				Optional:    true,
+			},
+			"schema_id": schema.StringAttribute{
+				Description: "ID of the identity schema the traits are validated against.",
+				Computed:    true,
+			},
+			"state": schema.StringAttribute{
+				Description: "State of the identity, either `active` or `inactive`.",
+				Computed:    true,
+			},
+			"traits": schema.StringAttribute{
+				Description: "JSON encoded traits of the identity.",
+				CustomType:  jsontypes.NormalizedType{},
+				Computed:    true,
+			},
+			"metadata_public": schema.StringAttribute{
+				Description: "JSON encoded metadata visible to the identity itself.",
+				CustomType:  jsontypes.NormalizedType{},
+				Computed:    true,
+			},
+			"metadata_admin": schema.StringAttribute{
+				Description: "JSON encoded metadata only visible through the admin API.",
+				CustomType:  jsontypes.NormalizedType{},
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *identityDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Reading identity data source")
+
+	var config identityDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var identity *client.Identity
+
+	if !config.ID.IsNull() {
+		found, httpResp, err := d.oryClient.ProjectAPIClient.IdentityAPI.GetIdentity(ctx, config.ID.ValueString()).Execute()
+
+		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+			resp.Diagnostics.AddError(
+				"ORY identity not found",
+				fmt.Sprintf("No identity with the ID %q exists.", config.ID.ValueString()),
+			)
+			return
+		}
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error fetching ORY identity",
+				"Could not retrieve ORY identity: "+oryclient.ErrorDetail(err),
+			)
+			return
+		}
+
+		identity = found
+	} else {
+		identities, err := oryclient.ListAllIdentities(ctx, d.oryClient.ProjectAPIClient, oryclient.IdentitiesFilter{
+			CredentialsIdentifier: config.CredentialsIdentifier.ValueString(),
+		})
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error fetching ORY identity",
+				"Could not retrieve ORY identity: "+oryclient.ErrorDetail(err),
+			)
+			return
+		}
+
+		if len(identities) == 0 {
+			resp.Diagnostics.AddError(
+				"ORY identity not found",
+				fmt.Sprintf("No identity with the credentials identifier %q exists.", config.CredentialsIdentifier.ValueString()),
+			)
+			return
+		}
+
+		identity = &identities[0]
+	}
+
+	diags = ApiToIdentity(identity, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,50 @@
+package identity_data_source_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kibblator/terraform-provider-ory/internal/provider/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOryIdentityDataSource(t *testing.T) {
+	randomName := acctest.GenerateRandomResourceName()
+	email := fmt.Sprintf("%s@example.com", randomName)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			// Read by ID and by credentials identifier
+			{
+				Config: fmt.Sprintf(`
+resource "ory_identity" "%s" {
+  schema_id = "preset://email"
+  traits    = jsonencode({ email = "%s" })
+
+  password_hash = "$2a$10$ZsCsoVQ3xfBG/K2z2XpBf.tm90GZmtOqtqWcB5.pYd5Eq8y7RlDyq"
+}
+
+data "ory_identity" "by_id" {
+  id = ory_identity.%s.id
+}
+
+data "ory_identity" "by_identifier" {
+  credentials_identifier = "%s"
+
+  depends_on = [ory_identity.%s]
+}
+`, randomName, email, randomName, email, randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.ory_identity.by_id", "id", fmt.Sprintf("ory_identity.%s", randomName), "id"),
+					resource.TestCheckResourceAttr("data.ory_identity.by_id", "state", "active"),
+					resource.TestCheckResourceAttr("data.ory_identity.by_id", "traits", fmt.Sprintf(`{"email":"%s"}`, email)),
+					resource.TestCheckResourceAttrPair("data.ory_identity.by_identifier", "id", fmt.Sprintf("ory_identity.%s", randomName), "id"),
+					resource.TestCheckResourceAttr("data.ory_identity.by_identifier", "schema_id", "preset://email"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,169 @@
+package workspace_projects_data_source
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	oryclient "github.com/kibblator/terraform-provider-ory/internal/provider/clients"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &workspaceProjectsDataSource{}
+	_ datasource.DataSourceWithConfigure = &workspaceProjectsDataSource{}
+)
+
+// NewWorkspaceProjectsDataSource is a helper function to simplify the provider implementation.
+func NewWorkspaceProjectsDataSource() datasource.DataSource {
+	return &workspaceProjectsDataSource{}
+}
+
+// workspaceProjectsDataSource is the data source implementation.
+type workspaceProjectsDataSource struct {
+	oryClient *oryclient.OryClient
+}
+
+type Project struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Slug        types.String `tfsdk:"slug"`
+	Environment types.String `tfsdk:"environment"`
+	HomeRegion  types.String `tfsdk:"home_region"`
+	State       types.String `tfsdk:"state"`
+}
+
+// workspaceProjectsDataSourceModel maps the data source schema data.
+type workspaceProjectsDataSourceModel struct {
+	WorkspaceID types.String `tfsdk:"workspace_id"`
+	Projects    []Project    `tfsdk:"projects"`
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *workspaceProjectsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*oryclient.OryClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected OryClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.oryClient = client
+}
+
+// Metadata returns the data source type name.
+func (d *workspaceProjectsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workspace_projects"
+}
+
+// Schema defines the schema for the data source.
+func (d *workspaceProjectsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the projects of a workspace.",
+		Attributes: map[string]schema.Attribute{
+			"workspace_id": schema.StringAttribute{
+				Description: "ID of the workspace. Defaults to the workspace of the configured project.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"projects": schema.ListNestedAttribute{
+				Description: "Projects of the workspace.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "ID of the project.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Name of the project.",
+							Computed:    true,
+						},
+						"slug": schema.StringAttribute{
+							Description: "Slug of the project, used in its API URL.",
+							Computed:    true,
+						},
+						"environment": schema.StringAttribute{
+							Description: "Environment of the project, for example `prod` or `dev`.",
+							Computed:    true,
+						},
+						"home_region": schema.StringAttribute{
+							Description: "Region the project data is stored in.",
+							Computed:    true,
+						},
+						"state": schema.StringAttribute{
+							Description: "State of the project, for example `running`.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *workspaceProjectsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Reading workspace projects data source")
+
+	var config workspaceProjectsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.WorkspaceID.IsNull() {
+		if d.oryClient.ProjectConfig.WorkspaceID == "" {
+			resp.Diagnostics.AddError(
+				"Missing ORY workspace",
+				"The configured project is not part of a workspace, set workspace_id.",
+			)
+			return
+		}
+
+		config.WorkspaceID = types.StringValue(d.oryClient.ProjectConfig.WorkspaceID)
+	}
+
+	projects, err := d.oryClient.APIClient.ListWorkspaceProjects(config.WorkspaceID.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error fetching ORY workspace projects",
+			"Could not retrieve ORY workspace projects: "+err.Error(),
+		)
+		return
+	}
+
+	config.Projects = make([]Project, 0, len(projects))
+	for _, project := range projects {
+		config.Projects = append(config.Projects, Project{
+			ID:          types.StringValue(project.Id),
+			Name:        types.StringValue(project.Name),
+			Slug:        types.StringValue(project.Slug),
+			Environment: types.StringValue(project.Environment),
+			HomeRegion:  types.StringValue(project.HomeRegion),
+			State:       types.StringValue(project.State),
+		})
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,37 @@
+package workspace_projects_data_source_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/kibblator/terraform-provider-ory/internal/provider/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOryWorkspaceProjectsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			// The configured project is part of its workspace
+			{
+				Config: `
+data "ory_workspace_projects" "all" {}
+
+locals {
+  project_ids = [for project in data.ory_workspace_projects.all.projects : project.id]
+}
+
+output "contains_project" {
+  value = contains(local.project_ids, "` + os.Getenv("ORY_PROJECT_ID") + `")
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ory_workspace_projects.all", "workspace_id"),
+					resource.TestCheckOutput("contains_project", "true"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,74 @@
+package functions
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/jsonpatch"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &jsonPatchFunction{}
+
+// NewJsonPatchFunction is a helper function to simplify the provider implementation.
+func NewJsonPatchFunction() function.Function {
+	return &jsonPatchFunction{}
+}
+
+// jsonPatchFunction is the function implementation.
+type jsonPatchFunction struct{}
+
+// Metadata returns the function name.
+func (f *jsonPatchFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "json_patch"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *jsonPatchFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Compute an RFC 6902 JSON patch between two documents",
+		Description: "Computes the RFC 6902 JSON patch operations that transform the first JSON document into the second, as sent to the Ory project API. Returns the operations as a JSON encoded array.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "a",
+				Description: "The JSON encoded source document.",
+			},
+			function.StringParameter{
+				Name:        "b",
+				Description: "The JSON encoded target document.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run computes the JSON patch.
+func (f *jsonPatchFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var a, b string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &a, &b))
+	if resp.Error != nil {
+		return
+	}
+
+	var from, to interface{}
+
+	if err := json.Unmarshal([]byte(a), &from); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, "Invalid JSON: "+err.Error())
+		return
+	}
+
+	if err := json.Unmarshal([]byte(b), &to); err != nil {
+		resp.Error = function.NewArgumentFuncError(1, "Invalid JSON: "+err.Error())
+		return
+	}
+
+	patch, err := json.Marshal(jsonpatch.Diff(from, to))
+	if err != nil {
+		resp.Error = function.NewFuncError("Could not encode JSON patch: " + err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, string(patch)))
+}
@@ -0,0 +1,31 @@
+package functions_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/acctest"
+)
+
+func TestAccJsonPatchFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = provider::ory::json_patch(
+    jsonencode({ session = { lifespan = "24h" }, old = true }),
+    jsonencode({ session = { lifespan = "48h" } })
+  )
+}
+`,
+				Check: resource.TestCheckOutput("test", `[{"op":"remove","path":"/old"},{"op":"replace","path":"/session/lifespan","value":"48h"}]`),
+			},
+		},
+	})
+}
@@ -0,0 +1,56 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/helpers"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &jsonnetBodyFunction{}
+
+// NewJsonnetBodyFunction is a helper function to simplify the provider implementation.
+func NewJsonnetBodyFunction() function.Function {
+	return &jsonnetBodyFunction{}
+}
+
+// jsonnetBodyFunction is the function implementation.
+type jsonnetBodyFunction struct{}
+
+// Metadata returns the function name.
+func (f *jsonnetBodyFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "jsonnet_body"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *jsonnetBodyFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Encode a Jsonnet body for Ory",
+		Description: "Checks the syntax of a Jsonnet source and base64 encodes it, ready to be used as an action body in Ory configuration.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "src",
+				Description: "The Jsonnet source, for example read with file().",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run encodes the Jsonnet body.
+func (f *jsonnetBodyFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var src string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &src))
+	if resp.Error != nil {
+		return
+	}
+
+	if err := helpers.CheckJsonnetSyntax(src); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, "Invalid Jsonnet: "+err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, helpers.EncodeJsonnetBody(src)))
+}
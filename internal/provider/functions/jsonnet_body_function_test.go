@@ -0,0 +1,37 @@
+package functions_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/acctest"
+)
+
+func TestAccJsonnetBodyFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = provider::ory::jsonnet_body("function(ctx) { to: ctx.recipient }")
+}
+`,
+				Check: resource.TestCheckOutput("test", "ZnVuY3Rpb24oY3R4KSB7IHRvOiBjdHgucmVjaXBpZW50IH0="),
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::ory::jsonnet_body("function(ctx) { to: ")
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Jsonnet`),
+			},
+		},
+	})
+}
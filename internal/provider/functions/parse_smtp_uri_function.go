@@ -0,0 +1,89 @@
+package functions
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/helpers"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/smtpuri"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &parseSmtpUriFunction{}
+
+var smtpUriAttributeTypes = map[string]attr.Type{
+	"username":     types.StringType,
+	"password":     types.StringType,
+	"host":         types.StringType,
+	"port":         types.StringType,
+	"path":         types.StringType,
+	"security":     types.StringType,
+	"server_name":  types.StringType,
+	"query_params": types.MapType{ElemType: types.ListType{ElemType: types.StringType}},
+}
+
+// NewParseSmtpUriFunction is a helper function to simplify the provider implementation.
+func NewParseSmtpUriFunction() function.Function {
+	return &parseSmtpUriFunction{}
+}
+
+// parseSmtpUriFunction is the function implementation.
+type parseSmtpUriFunction struct{}
+
+// Metadata returns the function name.
+func (f *parseSmtpUriFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_smtp_uri"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *parseSmtpUriFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Parse an Ory courier SMTP connection URI",
+		Description: "Parses an Ory courier SMTP connection URI into an object with the username, password, host, port, path, security, server_name and query_params attributes used by the ory_email_configuration resource.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "uri",
+				Description: "The SMTP connection URI to parse.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: smtpUriAttributeTypes,
+		},
+	}
+}
+
+// Run parses the SMTP connection URI.
+func (f *parseSmtpUriFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var uri string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &uri))
+	if resp.Error != nil {
+		return
+	}
+
+	connectionUri, err := smtpuri.Parse(uri)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, "Could not parse SMTP URI: "+err.Error())
+		return
+	}
+
+	result, diags := types.ObjectValue(smtpUriAttributeTypes, map[string]attr.Value{
+		"username":     types.StringValue(connectionUri.Username),
+		"password":     types.StringValue(connectionUri.Password),
+		"host":         types.StringValue(connectionUri.Host),
+		"port":         types.StringValue(connectionUri.Port),
+		"path":         types.StringValue(connectionUri.Path),
+		"security":     types.StringValue(connectionUri.Security),
+		"server_name":  types.StringValue(connectionUri.ServerName),
+		"query_params": helpers.QueryParamsToTf(connectionUri.Params),
+	})
+
+	resp.Error = function.FuncErrorFromDiags(ctx, diags)
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,104 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/smtpuri"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ function.Function = &smtpUriFunction{}
+
+// NewSmtpUriFunction is a helper function to simplify the provider implementation.
+func NewSmtpUriFunction() function.Function {
+	return &smtpUriFunction{}
+}
+
+// smtpUriFunction is the function implementation.
+type smtpUriFunction struct{}
+
+// Metadata returns the function name.
+func (f *smtpUriFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "smtp_uri"
+}
+
+// Definition defines the parameters and return type of the function.
+func (f *smtpUriFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Build an Ory courier SMTP connection URI",
+		Description: "Builds the SMTP connection URI Ory uses for courier.smtp.connection_uri, the same way the ory_email_configuration resource does.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "username",
+				Description: "The username for the SMTP server.",
+			},
+			function.StringParameter{
+				Name:        "password",
+				Description: "The password for the SMTP server.",
+			},
+			function.StringParameter{
+				Name:        "host",
+				Description: "The SMTP server host.",
+			},
+			function.StringParameter{
+				Name:        "port",
+				Description: "The SMTP server port.",
+			},
+			function.StringParameter{
+				Name:        "security",
+				Description: "The security type of the SMTP server. One of starttls, starttls_notrust, cleartext, implicittls or implicittls_notrust.",
+			},
+		},
+		VariadicParameter: function.MapParameter{
+			Name:        "options",
+			Description: "Optional maps of additional connection URI query parameters, such as server_name. Passing a parameter in several maps repeats it. skip_ssl_verify and disable_starttls are derived from security.",
+			ElementType: types.StringType,
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run builds the SMTP connection URI.
+func (f *smtpUriFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var username, password, host, port, security string
+	var options []map[string]string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &username, &password, &host, &port, &security, &options))
+	if resp.Error != nil {
+		return
+	}
+
+	if !slices.Contains(smtpuri.SecurityTypes, security) {
+		resp.Error = function.NewArgumentFuncError(4, fmt.Sprintf("security must be one of %v, got: %q", smtpuri.SecurityTypes, security))
+		return
+	}
+
+	connectionUri := smtpuri.URI{
+		Username: username,
+		Password: password,
+		Host:     host,
+		Port:     port,
+		Security: security,
+		Params:   url.Values{},
+	}
+
+	// Every options map adds its values, so a parameter can be repeated by
+	// passing it in several maps
+	for _, option := range options {
+		for key, value := range option {
+			if key == "skip_ssl_verify" || key == "disable_starttls" {
+				resp.Error = function.NewArgumentFuncError(5, fmt.Sprintf("the %s option is derived from security and can't be set", key))
+				return
+			}
+
+			connectionUri.Params.Add(key, value)
+		}
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, connectionUri.String()))
+}
@@ -0,0 +1,40 @@
+package helpers
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"sigs.k8s.io/yaml"
+)
+
+// ParseDocument decodes a JSON or YAML document into the generic form
+// produced by encoding/json.
+func ParseDocument(document string) (interface{}, error) {
+	encoded, err := yaml.YAMLToJSON([]byte(document))
+	if err != nil {
+		return nil, fmt.Errorf("error parsing document: %v", err)
+	}
+
+	var decoded interface{}
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return nil, fmt.Errorf("error parsing document: %v", err)
+	}
+
+	return decoded, nil
+}
+
+// NormalizeDocument returns the canonical JSON encoding of a JSON or YAML
+// document, with object keys sorted and insignificant whitespace removed.
+func NormalizeDocument(document string) (string, error) {
+	decoded, err := ParseDocument(document)
+	if err != nil {
+		return "", err
+	}
+
+	normalized, err := json.Marshal(decoded)
+	if err != nil {
+		return "", fmt.Errorf("error encoding document: %v", err)
+	}
+
+	return string(normalized), nil
+}
@@ -0,0 +1,31 @@
+package helpers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+// ValidateTraits validates identity traits against an identity schema. The
+// schema describes the whole identity, so the traits are validated wrapped in
+// an identity document.
+func ValidateTraits(identitySchema map[string]interface{}, traits interface{}) error {
+	encoded, err := json.Marshal(identitySchema)
+	if err != nil {
+		return err
+	}
+
+	compiler := jsonschema.NewCompiler()
+	if err := compiler.AddResource("identity.schema.json", bytes.NewReader(encoded)); err != nil {
+		return fmt.Errorf("invalid identity schema: %w", err)
+	}
+
+	schema, err := compiler.Compile("identity.schema.json")
+	if err != nil {
+		return fmt.Errorf("invalid identity schema: %w", err)
+	}
+
+	return schema.Validate(map[string]interface{}{"traits": traits})
+}
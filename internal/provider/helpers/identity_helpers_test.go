@@ -0,0 +1,52 @@
+package helpers_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/kibblator/terraform-provider-ory/internal/provider/helpers"
+)
+
+const identitySchema = `{
+  "$id": "https://schemas.ory.sh/presets/kratos/identity.email.schema.json",
+  "$schema": "http://json-schema.org/draft-07/schema#",
+  "type": "object",
+  "properties": {
+    "traits": {
+      "type": "object",
+      "properties": {
+        "email": {
+          "type": "string",
+          "format": "email",
+          "ory.sh/kratos": {
+            "credentials": { "password": { "identifier": true } }
+          }
+        },
+        "name": { "type": "string", "maxLength": 10 }
+      },
+      "required": ["email"],
+      "additionalProperties": false
+    }
+  }
+}`
+
+func TestValidateTraits(t *testing.T) {
+	var schema map[string]interface{}
+	if err := json.Unmarshal([]byte(identitySchema), &schema); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := helpers.ValidateTraits(schema, map[string]interface{}{"email": "admin@example.com", "name": "Admin"}); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	for _, traits := range []map[string]interface{}{
+		{"name": "Admin"},
+		{"email": "admin@example.com", "name": "Administrator"},
+		{"email": "admin@example.com", "phone": "+4912345678"},
+	} {
+		if err := helpers.ValidateTraits(schema, traits); err == nil {
+			t.Errorf("expected an error for %v", traits)
+		}
+	}
+}
@@ -0,0 +1,250 @@
+package helpers
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/ory/client-go"
+)
+
+// passwordHashPrefixes lists the prefixes of the password hash formats Ory can
+// import.
+var passwordHashPrefixes = []string{
+	"$2a$", "$2b$", "$2y$", // bcrypt
+	"$argon2id$", "$argon2i$",
+	"$pbkdf2-",
+	"$scrypt$", "$firescrypt$",
+	"$md5$", "$md5-crypt$", "$1$",
+	"$sha1$", "$sha256$", "$sha512$",
+	"$5$", "$6$", // sha256-crypt and sha512-crypt
+	"{SSHA}", "{SSHA256}", "{SSHA512}",
+}
+
+// IdentityImportRow is an identity of an import source.
+type IdentityImportRow struct {
+	Line       int
+	Identifier string
+	Identity   client.CreateIdentityBody
+}
+
+type identityImportRecord struct {
+	SchemaID       string                          `json:"schema_id"`
+	Traits         map[string]interface{}          `json:"traits"`
+	State          string                          `json:"state"`
+	MetadataPublic interface{}                     `json:"metadata_public"`
+	MetadataAdmin  interface{}                     `json:"metadata_admin"`
+	PasswordHash   string                          `json:"password_hash"`
+	Credentials    *client.IdentityWithCredentials `json:"credentials"`
+}
+
+// ParseIdentityImport parses the identities of a JSONL or CSV import source.
+// JSONL lines hold identities in the format of the identity API, with
+// password_hash as a shorthand for the hashed password credentials. CSV
+// sources have a header row, the columns schema_id, state, password_hash,
+// metadata_public and metadata_admin are read as such and all other columns
+// are traits, dots in their names denoting nested traits. Every row must have
+// a unique identifier in the given trait. Errors are returned per row.
+func ParseIdentityImport(source, format, defaultSchemaID, identifierTrait string) ([]IdentityImportRow, []error) {
+	var records map[int]identityImportRecord
+	var lines []int
+	var errs []error
+
+	switch format {
+	case "jsonl":
+		records, lines, errs = parseJSONLRecords(source)
+	case "csv":
+		records, lines, errs = parseCSVRecords(source)
+	default:
+		return nil, []error{fmt.Errorf("unsupported format %q", format)}
+	}
+
+	rows := []IdentityImportRow{}
+	seen := map[string]int{}
+
+	for _, line := range lines {
+		row, err := importRow(line, records[line], defaultSchemaID, identifierTrait)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		if previous, duplicate := seen[row.Identifier]; duplicate {
+			errs = append(errs, fmt.Errorf("line %d: identifier %q is already used on line %d", line, row.Identifier, previous))
+			continue
+		}
+
+		seen[row.Identifier] = line
+		rows = append(rows, row)
+	}
+
+	return rows, errs
+}
+
+func importRow(line int, record identityImportRecord, defaultSchemaID, identifierTrait string) (IdentityImportRow, error) {
+	identifier, ok := record.Traits[identifierTrait].(string)
+	if !ok || identifier == "" {
+		return IdentityImportRow{}, fmt.Errorf("line %d: missing identifier trait %q", line, identifierTrait)
+	}
+
+	identity := client.CreateIdentityBody{
+		SchemaId:       record.SchemaID,
+		Traits:         record.Traits,
+		MetadataPublic: record.MetadataPublic,
+		MetadataAdmin:  record.MetadataAdmin,
+		Credentials:    record.Credentials,
+	}
+
+	if identity.SchemaId == "" {
+		identity.SchemaId = defaultSchemaID
+	}
+
+	if record.State != "" {
+		identity.State = &record.State
+	}
+
+	if record.PasswordHash != "" {
+		identity.Credentials = &client.IdentityWithCredentials{
+			Password: &client.IdentityWithCredentialsPassword{
+				Config: &client.IdentityWithCredentialsPasswordConfig{
+					HashedPassword: &record.PasswordHash,
+				},
+			},
+		}
+	}
+
+	if password := identity.Credentials.GetPassword(); password.Config != nil && password.Config.HashedPassword != nil {
+		if !isSupportedPasswordHash(*password.Config.HashedPassword) {
+			return IdentityImportRow{}, fmt.Errorf("line %d: unsupported password hash format for %q", line, identifier)
+		}
+	}
+
+	return IdentityImportRow{Line: line, Identifier: identifier, Identity: identity}, nil
+}
+
+func isSupportedPasswordHash(hash string) bool {
+	for _, prefix := range passwordHashPrefixes {
+		if strings.HasPrefix(hash, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func parseJSONLRecords(source string) (map[int]identityImportRecord, []int, []error) {
+	records := map[int]identityImportRecord{}
+	lines := []int{}
+	var errs []error
+
+	scanner := bufio.NewScanner(strings.NewReader(source))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+
+		var record identityImportRecord
+		if err := json.Unmarshal([]byte(text), &record); err != nil {
+			errs = append(errs, fmt.Errorf("line %d: %w", line, err))
+			continue
+		}
+
+		records[line] = record
+		lines = append(lines, line)
+	}
+
+	if err := scanner.Err(); err != nil {
+		errs = append(errs, err)
+	}
+
+	return records, lines, errs
+}
+
+func parseCSVRecords(source string) (map[int]identityImportRecord, []int, []error) {
+	records := map[int]identityImportRecord{}
+	lines := []int{}
+	var errs []error
+
+	reader := csv.NewReader(strings.NewReader(source))
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, nil, []error{fmt.Errorf("line 1: could not read the header: %w", err)}
+	}
+
+	for {
+		values, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			errs = append(errs, err)
+			if _, isParseErr := err.(*csv.ParseError); isParseErr {
+				continue
+			}
+			break
+		}
+
+		line, _ := reader.FieldPos(0)
+
+		record := identityImportRecord{Traits: map[string]interface{}{}}
+		var rowErr error
+
+		for i, column := range header {
+			value := values[i]
+			if value == "" {
+				continue
+			}
+
+			switch column {
+			case "schema_id":
+				record.SchemaID = value
+			case "state":
+				record.State = value
+			case "password_hash":
+				record.PasswordHash = value
+			case "metadata_public":
+				rowErr = json.Unmarshal([]byte(value), &record.MetadataPublic)
+			case "metadata_admin":
+				rowErr = json.Unmarshal([]byte(value), &record.MetadataAdmin)
+			default:
+				setNestedTrait(record.Traits, strings.Split(column, "."), value)
+			}
+
+			if rowErr != nil {
+				rowErr = fmt.Errorf("line %d: invalid %s: %w", line, column, rowErr)
+				break
+			}
+		}
+
+		if rowErr != nil {
+			errs = append(errs, rowErr)
+			continue
+		}
+
+		records[line] = record
+		lines = append(lines, line)
+	}
+
+	return records, lines, errs
+}
+
+func setNestedTrait(traits map[string]interface{}, keys []string, value string) {
+	for _, key := range keys[:len(keys)-1] {
+		nested, ok := traits[key].(map[string]interface{})
+		if !ok {
+			nested = map[string]interface{}{}
+			traits[key] = nested
+		}
+		traits = nested
+	}
+
+	traits[keys[len(keys)-1]] = value
+}
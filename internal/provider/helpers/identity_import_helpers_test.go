@@ -0,0 +1,90 @@
+package helpers_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/kibblator/terraform-provider-ory/internal/provider/helpers"
+)
+
+func TestParseIdentityImportJSONL(t *testing.T) {
+	source := `{"traits": {"email": "a@example.com"}, "password_hash": "$2a$10$ZsCsoVQ3xfBG/K2z2XpBf.tm90GZmtOqtqWcB5.pYd5Eq8y7RlDyq"}
+
+{"schema_id": "customer", "traits": {"email": "b@example.com"}, "metadata_public": {"plan": "pro"}}
+{"traits": {"email": "a@example.com"}}
+{"traits": {"name": "c"}}
+{"traits": {"email": "d@example.com"}, "password_hash": "plain"}
+not json
+`
+
+	rows, errs := helpers.ParseIdentityImport(source, "jsonl", "preset://email", "email")
+
+	if len(rows) != 2 {
+		t.Fatalf("expected 2 rows, got %d", len(rows))
+	}
+
+	if rows[0].Identifier != "a@example.com" || rows[0].Identity.SchemaId != "preset://email" || rows[0].Line != 1 {
+		t.Errorf("unexpected first row %+v", rows[0])
+	}
+
+	if rows[0].Identity.Credentials.GetPassword().Config.GetHashedPassword() == "" {
+		t.Error("expected the password hash to be imported")
+	}
+
+	if rows[1].Identity.SchemaId != "customer" || rows[1].Line != 3 {
+		t.Errorf("unexpected second row %+v", rows[1])
+	}
+
+	expected := []string{
+		`line 4: identifier "a@example.com" is already used on line 1`,
+		`line 5: missing identifier trait "email"`,
+		`line 6: unsupported password hash format`,
+		`line 7:`,
+	}
+
+	if len(errs) != len(expected) {
+		t.Fatalf("expected %d errors, got %v", len(expected), errs)
+	}
+
+	for _, message := range expected {
+		found := false
+		for _, err := range errs {
+			found = found || strings.Contains(err.Error(), message)
+		}
+
+		if !found {
+			t.Errorf("expected an error containing %q, got %v", message, errs)
+		}
+	}
+}
+
+func TestParseIdentityImportCSV(t *testing.T) {
+	source := `email,name.first,password_hash,metadata_admin
+a@example.com,Ada,"$argon2id$v=19$m=16,t=2,p=1$c2FsdA$aGFzaA",
+b@example.com,,,"{""source"": ""legacy""}"
+c@example.com,,,{invalid
+`
+
+	rows, errs := helpers.ParseIdentityImport(source, "csv", "preset://email", "email")
+
+	if len(rows) != 2 || len(errs) != 1 {
+		t.Fatalf("expected 2 rows and 1 error, got %d rows and %v", len(rows), errs)
+	}
+
+	name, _ := rows[0].Identity.Traits["name"].(map[string]interface{})
+	if name["first"] != "Ada" {
+		t.Errorf("expected a nested name trait, got %v", rows[0].Identity.Traits)
+	}
+
+	if _, ok := rows[1].Identity.Traits["name"]; ok {
+		t.Errorf("expected empty columns to be skipped, got %v", rows[1].Identity.Traits)
+	}
+
+	if rows[1].Identity.MetadataAdmin == nil {
+		t.Error("expected the admin metadata to be parsed")
+	}
+
+	if !strings.Contains(errs[0].Error(), "line 4: invalid metadata_admin") {
+		t.Errorf("unexpected error %v", errs[0])
+	}
+}
@@ -0,0 +1,29 @@
+package helpers
+
+import (
+	"encoding/base64"
+
+	"github.com/google/go-jsonnet"
+)
+
+// EvaluateJsonnet evaluates a Jsonnet snippet the way Ory does, passing the
+// sample context both as the ctx top-level argument and the ctx external
+// variable, and returns the resulting JSON.
+func EvaluateJsonnet(snippet string, sampleContext string) (string, error) {
+	vm := jsonnet.MakeVM()
+	vm.TLACode("ctx", sampleContext)
+	vm.ExtCode("ctx", sampleContext)
+
+	return vm.EvaluateAnonymousSnippet("body.jsonnet", snippet)
+}
+
+// EncodeJsonnetBody base64 encodes a Jsonnet body for use in Ory configuration.
+func EncodeJsonnetBody(snippet string) string {
+	return base64.StdEncoding.EncodeToString([]byte(snippet))
+}
+
+// CheckJsonnetSyntax parses a Jsonnet snippet without evaluating it.
+func CheckJsonnetSyntax(snippet string) error {
+	_, err := jsonnet.SnippetToAST("body.jsonnet", snippet)
+	return err
+}
@@ -0,0 +1,163 @@
+package helpers
+
+import (
+	"encoding/base64"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const base64URLPrefix = "base64://"
+
+var oplClassPattern = regexp.MustCompile(`\bclass\s+([A-Za-z_$][\w$]*)(?:\s+implements\s+([A-Za-z_$][\w$]*))?`)
+
+// CheckOPL performs a lightweight syntax and structure check of an Ory
+// Permission Language source: comments and strings must be terminated,
+// brackets balanced and every class must implement Namespace. It returns the
+// declared namespaces in order of declaration.
+func CheckOPL(source string) ([]string, error) {
+	code, err := stripOPLLiterals(source)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkOPLBrackets(code); err != nil {
+		return nil, err
+	}
+
+	namespaces := []string{}
+	seen := map[string]bool{}
+
+	for _, match := range oplClassPattern.FindAllStringSubmatch(code, -1) {
+		name, implements := match[1], match[2]
+
+		if implements != "Namespace" {
+			return nil, fmt.Errorf("class %s must implement Namespace", name)
+		}
+
+		if seen[name] {
+			return nil, fmt.Errorf("namespace %s is declared more than once", name)
+		}
+
+		seen[name] = true
+		namespaces = append(namespaces, name)
+	}
+
+	if len(namespaces) == 0 {
+		return nil, fmt.Errorf("no namespace declared, expected at least one class implementing Namespace")
+	}
+
+	return namespaces, nil
+}
+
+// EncodeBase64URL returns the content as a base64:// URL as used for inline
+// files in Ory configuration.
+func EncodeBase64URL(content string) string {
+	return base64URLPrefix + base64.StdEncoding.EncodeToString([]byte(content))
+}
+
+// DecodeBase64URL returns the content of a base64:// URL, and false for other
+// locations.
+func DecodeBase64URL(location string) (string, bool) {
+	if !strings.HasPrefix(location, base64URLPrefix) {
+		return "", false
+	}
+
+	content, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(location, base64URLPrefix))
+	if err != nil {
+		return "", false
+	}
+
+	return string(content), true
+}
+
+// stripOPLLiterals blanks out comments and string literals, keeping line
+// breaks so positions in the result match the source.
+func stripOPLLiterals(source string) (string, error) {
+	var code strings.Builder
+	line := 1
+
+	for i := 0; i < len(source); i++ {
+		c := source[i]
+
+		switch {
+		case c == '/' && i+1 < len(source) && source[i+1] == '/':
+			for i < len(source) && source[i] != '\n' {
+				i++
+			}
+			if i < len(source) {
+				code.WriteByte('\n')
+				line++
+			}
+		case c == '/' && i+1 < len(source) && source[i+1] == '*':
+			start := line
+			end := strings.Index(source[i+2:], "*/")
+			if end < 0 {
+				return "", fmt.Errorf("line %d: unterminated comment", start)
+			}
+			comment := source[i : i+2+end+2]
+			line += strings.Count(comment, "\n")
+			code.WriteString(strings.Repeat("\n", strings.Count(comment, "\n")))
+			i += len(comment) - 1
+		case c == '"' || c == '\'' || c == '`':
+			start := line
+			i++
+			for i < len(source) && source[i] != c {
+				if source[i] == '\\' {
+					i++
+				} else if source[i] == '\n' {
+					if c != '`' {
+						return "", fmt.Errorf("line %d: unterminated string", start)
+					}
+					code.WriteByte('\n')
+					line++
+				}
+				i++
+			}
+			if i >= len(source) {
+				return "", fmt.Errorf("line %d: unterminated string", start)
+			}
+			code.WriteString(`""`)
+		default:
+			if c == '\n' {
+				line++
+			}
+			code.WriteByte(c)
+		}
+	}
+
+	return code.String(), nil
+}
+
+func checkOPLBrackets(code string) error {
+	pairs := map[byte]byte{')': '(', ']': '[', '}': '{'}
+
+	type bracket struct {
+		char byte
+		line int
+	}
+
+	var stack []bracket
+	line := 1
+
+	for i := 0; i < len(code); i++ {
+		switch c := code[i]; c {
+		case '\n':
+			line++
+		case '(', '[', '{':
+			stack = append(stack, bracket{char: c, line: line})
+		case ')', ']', '}':
+			if len(stack) == 0 || stack[len(stack)-1].char != pairs[c] {
+				return fmt.Errorf("line %d: unexpected %q", line, c)
+			}
+			stack = stack[:len(stack)-1]
+		}
+	}
+
+	if len(stack) > 0 {
+		open := stack[len(stack)-1]
+		return fmt.Errorf("line %d: %q is never closed", open.line, open.char)
+	}
+
+	return nil
+}
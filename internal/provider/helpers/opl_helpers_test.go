@@ -0,0 +1,74 @@
+package helpers_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/kibblator/terraform-provider-ory/internal/provider/helpers"
+)
+
+const validOPL = `import { Namespace, Context } from "@ory/keto-namespace-types"
+
+class User implements Namespace {}
+
+// A document { with a comment
+class Document implements Namespace {
+  related: {
+    owners: User[]
+    viewers: User[]
+  }
+
+  permits = {
+    view: (ctx: Context): boolean =>
+      this.related.viewers.includes(ctx.subject) ||
+      this.related.owners.includes(ctx.subject),
+  }
+}
+`
+
+func TestCheckOPL(t *testing.T) {
+	namespaces, err := helpers.CheckOPL(validOPL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"User", "Document"}
+	if !reflect.DeepEqual(namespaces, expected) {
+		t.Errorf("expected %v, got %v", expected, namespaces)
+	}
+
+	cases := map[string]string{
+		"class User implements Namespace {":     "line 1: '{' is never closed",
+		"class User implements Namespace {}\n}": "line 2: unexpected '}'",
+		"class User {}":                         "class User must implement Namespace",
+		"class User implements Namespace {}\nclass User implements Namespace {}": "namespace User is declared more than once",
+		"const a = 1": "no namespace declared",
+		"class User implements Namespace {}\n/* open":       "line 2: unterminated comment",
+		"class User implements Namespace {}\nconst a = \"b": "line 2: unterminated string",
+	}
+
+	for source, message := range cases {
+		_, err := helpers.CheckOPL(source)
+		if err == nil || !strings.Contains(err.Error(), message) {
+			t.Errorf("expected an error containing %q for %q, got %v", message, source, err)
+		}
+	}
+}
+
+func TestBase64URL(t *testing.T) {
+	location := helpers.EncodeBase64URL(validOPL)
+
+	if !strings.HasPrefix(location, "base64://") {
+		t.Errorf("expected a base64:// URL, got %q", location)
+	}
+
+	content, ok := helpers.DecodeBase64URL(location)
+	if !ok || content != validOPL {
+		t.Errorf("expected the encoded content, got %q", content)
+	}
+
+	if _, ok := helpers.DecodeBase64URL("https://example.com/namespaces.ts"); ok {
+		t.Error("expected other locations not to be decoded")
+	}
+}
@@ -0,0 +1,59 @@
+package helpers
+
+import (
+	"sort"
+
+	"github.com/kibblator/terraform-provider-ory/internal/provider/jsonpatch"
+)
+
+// TypedResourcePaths maps the project configuration paths managed by typed
+// resources to the resource type managing them.
+var TypedResourcePaths = map[string]string{
+	"/cors_public":                                                            "ory_cors",
+	"/cors_admin":                                                             "ory_cors",
+	"/services/identity/config/courier/smtp":                                  "ory_email_configuration",
+	"/services/identity/config/courier/http":                                  "ory_email_configuration",
+	"/services/identity/config/courier/delivery_strategy":                     "ory_email_configuration",
+	"/services/identity/config/selfservice/flows/registration/enabled":        "ory_registration",
+	"/services/identity/config/selfservice/flows/registration/login_hints":    "ory_registration",
+	"/services/identity/config/selfservice/flows/registration/after/password": "ory_registration",
+	"/services/identity/config/selfservice/methods/password/enabled":          "ory_registration",
+	"/services/identity/config/selfservice/methods/oidc/config/providers":     "ory_organization_sso_provider",
+	"/services/identity/config/selfservice/methods/saml/config/providers":     "ory_saml_provider",
+	"/services/identity/config/selfservice/allowed_return_urls":               "ory_allowed_return_urls",
+	"/services/identity/config/selfservice/default_browser_return_url":        "ory_allowed_return_urls",
+	"/services/identity/config/session/lifespan":                              "ory_session_settings",
+	"/services/identity/config/session/earliest_possible_extend":              "ory_session_settings",
+	"/services/identity/config/session/cookie":                                "ory_session_settings",
+	"/services/identity/config/session/whoami/tokenizer":                      "ory_session_settings",
+	"/services/oauth2/config/urls/self/issuer":                                "ory_oauth2_settings",
+	"/services/oauth2/config/urls/login":                                      "ory_oauth2_settings",
+	"/services/oauth2/config/urls/consent":                                    "ory_oauth2_settings",
+	"/services/oauth2/config/urls/logout":                                     "ory_oauth2_settings",
+	"/services/oauth2/config/urls/error":                                      "ory_oauth2_settings",
+	"/services/oauth2/config/urls/post_logout_redirect":                       "ory_oauth2_settings",
+	"/services/oauth2/config/ttl/access_token":                                "ory_oauth2_settings",
+	"/services/oauth2/config/ttl/refresh_token":                               "ory_oauth2_settings",
+	"/services/oauth2/config/ttl/id_token":                                    "ory_oauth2_settings",
+	"/services/oauth2/config/ttl/auth_code":                                   "ory_oauth2_settings",
+	"/services/oauth2/config/strategies/access_token":                         "ory_oauth2_settings",
+	"/services/oauth2/config/oauth2/token_hook":                               "ory_oauth2_settings",
+	"/services/oauth2/config/oauth2/grant/jwt":                                "ory_oauth2_settings",
+	"/services/permission/config/namespaces":                                  "ory_permission_namespaces",
+}
+
+// TypedResourceConflicts returns the typed resource paths overlapping with the
+// given JSON pointer, sorted for stable diagnostics.
+func TypedResourceConflicts(pointer string) []string {
+	conflicts := []string{}
+
+	for path := range TypedResourcePaths {
+		if jsonpatch.Overlaps(pointer, path) {
+			conflicts = append(conflicts, path)
+		}
+	}
+
+	sort.Strings(conflicts)
+
+	return conflicts
+}
@@ -0,0 +1,40 @@
+package helpers
+
+import (
+	"net/url"
+	"strings"
+)
+
+// NextPageToken returns the page token of the `rel="next"` link of a Link
+// header as returned by the Ory APIs, or an empty string on the last page.
+func NextPageToken(header string) string {
+	for _, link := range strings.Split(header, ",") {
+		parts := strings.Split(link, ";")
+		target := strings.TrimSpace(parts[0])
+
+		if !strings.HasPrefix(target, "<") || !strings.HasSuffix(target, ">") {
+			continue
+		}
+
+		isNext := false
+		for _, param := range parts[1:] {
+			name, value, found := strings.Cut(strings.TrimSpace(param), "=")
+			if found && strings.EqualFold(name, "rel") && strings.Trim(value, `"`) == "next" {
+				isNext = true
+			}
+		}
+
+		if !isNext {
+			continue
+		}
+
+		parsed, err := url.Parse(strings.Trim(target, "<>"))
+		if err != nil {
+			return ""
+		}
+
+		return parsed.Query().Get("page_token")
+	}
+
+	return ""
+}
@@ -0,0 +1,20 @@
+package helpers_test
+
+import (
+	"testing"
+
+	"github.com/kibblator/terraform-provider-ory/internal/provider/helpers"
+)
+
+func TestNextPageToken(t *testing.T) {
+	for header, expected := range map[string]string{
+		"": "",
+		`</admin/identities?page_size=250&page_token=first>; rel="first"`:                                                                 "",
+		`</admin/identities?page_size=250&page_token=first>; rel="first",</admin/identities?page_size=250&page_token=abc%3D>; rel="next"`: "abc=",
+		`<https://example.projects.oryapis.com/admin/identities?page_token=xyz&page_size=2>;rel=next`:                                     "xyz",
+	} {
+		if token := helpers.NextPageToken(header); token != expected {
+			t.Errorf("expected %q for %q, got %q", expected, header, token)
+		}
+	}
+}
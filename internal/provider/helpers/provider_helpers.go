@@ -0,0 +1,96 @@
+package helpers
+
+import (
+	"fmt"
+
+	orytypes "github.com/kibblator/terraform-provider-ory/internal/provider/types"
+	"github.com/ory/client-go"
+)
+
+const (
+	MethodOIDC = "oidc"
+	MethodSAML = "saml"
+
+	methodsPath = "/services/identity/config/selfservice/methods"
+)
+
+// LiveProviderMethod returns the configuration of the OIDC or SAML sign-in
+// method of the project, or nil when it isn't configured.
+func LiveProviderMethod(project *orytypes.Project, method string) *orytypes.ProviderMethod {
+	selfService := project.Services.Identity.Config.SelfService
+	if selfService == nil || selfService.Methods == nil {
+		return nil
+	}
+
+	if method == MethodSAML {
+		return selfService.Methods.SAML
+	}
+
+	return selfService.Methods.OIDC
+}
+
+// FindProvider returns the index of the provider with the given ID in the live
+// method configuration, or -1.
+func FindProvider(live *orytypes.ProviderMethod, providerID string) int {
+	if live == nil || live.Config == nil {
+		return -1
+	}
+
+	for i, provider := range live.Config.Providers {
+		if provider.ID == providerID {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// ProviderPatch returns the patch adding or replacing the provider with the
+// given ID in the live method configuration, or removing it when provider is
+// nil. Missing parents are created and the method is enabled when a provider
+// is added.
+func ProviderPatch(method string, live *orytypes.ProviderMethod, providerID string, provider *orytypes.Provider) []client.JsonPatch {
+	methodPath := methodsPath + "/" + method
+	index := FindProvider(live, providerID)
+
+	if provider == nil {
+		if index < 0 {
+			return nil
+		}
+
+		return []client.JsonPatch{
+			{Op: "remove", Path: fmt.Sprintf("%s/config/providers/%d", methodPath, index)},
+		}
+	}
+
+	if index >= 0 {
+		return []client.JsonPatch{
+			{Op: "replace", Path: fmt.Sprintf("%s/config/providers/%d", methodPath, index), Value: provider},
+		}
+	}
+
+	switch {
+	case live == nil:
+		return []client.JsonPatch{
+			{Op: "add", Path: methodPath, Value: orytypes.ProviderMethod{
+				Enabled: true,
+				Config:  &orytypes.ProviderMethodConfig{Providers: []orytypes.Provider{*provider}},
+			}},
+		}
+	case live.Config == nil:
+		return []client.JsonPatch{
+			{Op: "add", Path: methodPath + "/enabled", Value: true},
+			{Op: "add", Path: methodPath + "/config", Value: orytypes.ProviderMethodConfig{Providers: []orytypes.Provider{*provider}}},
+		}
+	case live.Config.Providers == nil:
+		return []client.JsonPatch{
+			{Op: "add", Path: methodPath + "/enabled", Value: true},
+			{Op: "add", Path: methodPath + "/config/providers", Value: []orytypes.Provider{*provider}},
+		}
+	default:
+		return []client.JsonPatch{
+			{Op: "add", Path: methodPath + "/enabled", Value: true},
+			{Op: "add", Path: methodPath + "/config/providers/-", Value: provider},
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package helpers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ory/client-go"
+)
+
+// FormatRelationship returns the relationship in the Ory Permissions string
+// notation, namespace:object#relation@subject, where the subject is either a
+// subject ID or a namespace:object#relation subject set.
+func FormatRelationship(relationship client.Relationship) string {
+	tuple := fmt.Sprintf("%s:%s#%s@", relationship.Namespace, relationship.Object, relationship.Relation)
+
+	if set := relationship.SubjectSet; set != nil {
+		return tuple + fmt.Sprintf("%s:%s#%s", set.Namespace, set.Object, set.Relation)
+	}
+
+	return tuple + relationship.GetSubjectId()
+}
+
+// ParseRelationship parses a relationship in the notation of
+// FormatRelationship. Subject sets may leave out the relation.
+func ParseRelationship(tuple string) (client.Relationship, error) {
+	object, subject, found := strings.Cut(tuple, "@")
+	if !found || subject == "" {
+		return client.Relationship{}, fmt.Errorf("expected namespace:object#relation@subject, got %q", tuple)
+	}
+
+	namespace, object, relation, err := parseObjectRelation(object)
+	if err != nil || relation == "" {
+		return client.Relationship{}, fmt.Errorf("expected namespace:object#relation@subject, got %q", tuple)
+	}
+
+	relationship := client.Relationship{
+		Namespace: namespace,
+		Object:    object,
+		Relation:  relation,
+	}
+
+	if !strings.Contains(subject, ":") {
+		relationship.SubjectId = &subject
+		return relationship, nil
+	}
+
+	setNamespace, setObject, setRelation, err := parseObjectRelation(subject)
+	if err != nil {
+		return client.Relationship{}, fmt.Errorf("invalid subject set in %q", tuple)
+	}
+
+	relationship.SubjectSet = &client.SubjectSet{
+		Namespace: setNamespace,
+		Object:    setObject,
+		Relation:  setRelation,
+	}
+
+	return relationship, nil
+}
+
+func parseObjectRelation(value string) (string, string, string, error) {
+	namespace, rest, found := strings.Cut(value, ":")
+	if !found || namespace == "" || rest == "" {
+		return "", "", "", fmt.Errorf("expected namespace:object, got %q", value)
+	}
+
+	object, relation, _ := strings.Cut(rest, "#")
+	if object == "" {
+		return "", "", "", fmt.Errorf("expected namespace:object, got %q", value)
+	}
+
+	return namespace, object, relation, nil
+}
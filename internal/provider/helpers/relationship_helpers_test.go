@@ -0,0 +1,39 @@
+package helpers_test
+
+import (
+	"testing"
+
+	"github.com/kibblator/terraform-provider-ory/internal/provider/helpers"
+)
+
+func TestParseRelationship(t *testing.T) {
+	for _, tuple := range []string{
+		"Group:admins#members@user-1",
+		"Document:readme#viewers@Group:admins#members",
+		"Document:readme#viewers@Group:admins#",
+	} {
+		relationship, err := helpers.ParseRelationship(tuple)
+		if err != nil {
+			t.Fatalf("unexpected error for %q: %s", tuple, err)
+		}
+
+		if formatted := helpers.FormatRelationship(relationship); formatted != tuple {
+			t.Errorf("expected %q, got %q", tuple, formatted)
+		}
+	}
+
+	relationship, err := helpers.ParseRelationship("Document:readme#viewers@Group:admins")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if relationship.SubjectSet == nil || relationship.SubjectSet.Object != "admins" || relationship.SubjectSet.Relation != "" {
+		t.Errorf("expected a subject set without relation, got %+v", relationship.SubjectSet)
+	}
+
+	for _, invalid := range []string{"", "Group:admins#members", "Group:admins@user-1", "admins#members@user-1", "Group:admins#members@Group:"} {
+		if _, err := helpers.ParseRelationship(invalid); err == nil {
+			t.Errorf("expected an error for %q", invalid)
+		}
+	}
+}
@@ -0,0 +1,189 @@
+package helpers
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	samlMetadataFetchTimeout = 10 * time.Second
+	samlMetadataMaxSize      = 1 << 20
+)
+
+// SAMLMetadata holds the parts of SAML identity provider metadata checked
+// before a provider is configured.
+type SAMLMetadata struct {
+	EntityID            string
+	SigningCertificates []*x509.Certificate
+}
+
+type samlEntityDescriptor struct {
+	EntityID          string                 `xml:"entityID,attr"`
+	IDPSSODescriptors []samlIDPSSODescriptor `xml:"IDPSSODescriptor"`
+}
+
+type samlEntitiesDescriptor struct {
+	EntityDescriptors []samlEntityDescriptor `xml:"EntityDescriptor"`
+}
+
+type samlIDPSSODescriptor struct {
+	KeyDescriptors []samlKeyDescriptor `xml:"KeyDescriptor"`
+}
+
+type samlKeyDescriptor struct {
+	Use          string   `xml:"use,attr"`
+	Certificates []string `xml:"KeyInfo>X509Data>X509Certificate"`
+}
+
+// ParseSAMLMetadata parses the metadata XML of a SAML identity provider and
+// returns its entity ID and signing certificates. Metadata wrapped in an
+// EntitiesDescriptor must describe exactly one identity provider.
+func ParseSAMLMetadata(metadata string) (*SAMLMetadata, error) {
+	var root struct {
+		XMLName xml.Name
+	}
+	if err := xml.Unmarshal([]byte(metadata), &root); err != nil {
+		return nil, fmt.Errorf("invalid metadata XML: %w", err)
+	}
+
+	var entity samlEntityDescriptor
+
+	switch root.XMLName.Local {
+	case "EntityDescriptor":
+		if err := xml.Unmarshal([]byte(metadata), &entity); err != nil {
+			return nil, fmt.Errorf("invalid metadata XML: %w", err)
+		}
+	case "EntitiesDescriptor":
+		var entities samlEntitiesDescriptor
+		if err := xml.Unmarshal([]byte(metadata), &entities); err != nil {
+			return nil, fmt.Errorf("invalid metadata XML: %w", err)
+		}
+
+		idps := []samlEntityDescriptor{}
+		for _, descriptor := range entities.EntityDescriptors {
+			if len(descriptor.IDPSSODescriptors) > 0 {
+				idps = append(idps, descriptor)
+			}
+		}
+
+		if len(idps) != 1 {
+			return nil, fmt.Errorf("expected the metadata to describe one identity provider, found %d", len(idps))
+		}
+		entity = idps[0]
+	default:
+		return nil, fmt.Errorf("expected an EntityDescriptor root element, found %s", root.XMLName.Local)
+	}
+
+	if entity.EntityID == "" {
+		return nil, errors.New("the metadata has no entityID")
+	}
+
+	if len(entity.IDPSSODescriptors) == 0 {
+		return nil, errors.New("the metadata has no IDPSSODescriptor")
+	}
+
+	parsed := &SAMLMetadata{EntityID: entity.EntityID}
+
+	for _, descriptor := range entity.IDPSSODescriptors {
+		for _, key := range descriptor.KeyDescriptors {
+			if key.Use != "" && key.Use != "signing" {
+				continue
+			}
+
+			for _, encoded := range key.Certificates {
+				der, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(encoded), ""))
+				if err != nil {
+					return nil, fmt.Errorf("invalid signing certificate encoding: %w", err)
+				}
+
+				certificate, err := x509.ParseCertificate(der)
+				if err != nil {
+					return nil, fmt.Errorf("invalid signing certificate: %w", err)
+				}
+
+				parsed.SigningCertificates = append(parsed.SigningCertificates, certificate)
+			}
+		}
+	}
+
+	if len(parsed.SigningCertificates) == 0 {
+		return nil, errors.New("the metadata has no signing certificate")
+	}
+
+	return parsed, nil
+}
+
+// CheckSAMLCertificates returns an error when none of the signing certificates
+// is currently valid, and warnings for certificates that are expired or expire
+// within the given window.
+func CheckSAMLCertificates(certificates []*x509.Certificate, now time.Time, window time.Duration) ([]string, error) {
+	warnings := []string{}
+	valid := 0
+
+	for _, certificate := range certificates {
+		subject := certificate.Subject.String()
+		if subject == "" {
+			subject = certificate.SerialNumber.String()
+		}
+
+		switch {
+		case now.After(certificate.NotAfter):
+			warnings = append(warnings, fmt.Sprintf("the signing certificate %s expired on %s", subject, certificate.NotAfter.Format(time.DateOnly)))
+		case now.Before(certificate.NotBefore):
+			warnings = append(warnings, fmt.Sprintf("the signing certificate %s is not valid before %s", subject, certificate.NotBefore.Format(time.DateOnly)))
+		default:
+			valid++
+
+			if certificate.NotAfter.Sub(now) < window {
+				warnings = append(warnings, fmt.Sprintf("the signing certificate %s expires on %s", subject, certificate.NotAfter.Format(time.DateOnly)))
+			}
+		}
+	}
+
+	if valid == 0 {
+		return nil, errors.New("none of the signing certificates is currently valid")
+	}
+
+	return warnings, nil
+}
+
+// FetchSAMLMetadata fetches the metadata XML of a SAML identity provider. The
+// fetch is bounded in time and size.
+func FetchSAMLMetadata(ctx context.Context, metadataURL string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, samlMetadataFetchTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, metadataURL, nil)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("received status %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, samlMetadataMaxSize+1))
+	if err != nil {
+		return "", err
+	}
+
+	if len(body) > samlMetadataMaxSize {
+		return "", fmt.Errorf("the metadata exceeds the maximum size of %d bytes", samlMetadataMaxSize)
+	}
+
+	return string(body), nil
+}
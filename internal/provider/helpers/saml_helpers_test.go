@@ -0,0 +1,119 @@
+package helpers_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/kibblator/terraform-provider-ory/internal/provider/helpers"
+)
+
+func TestParseSAMLMetadata(t *testing.T) {
+	certificate := testCertificate(t, time.Now().Add(-time.Hour), time.Now().Add(365*24*time.Hour))
+
+	metadata := fmt.Sprintf(`<?xml version="1.0"?>
+<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" xmlns:ds="http://www.w3.org/2000/09/xmldsig#" entityID="https://idp.example.com/metadata">
+  <md:IDPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+    <md:KeyDescriptor use="encryption">
+      <ds:KeyInfo><ds:X509Data><ds:X509Certificate>not a certificate</ds:X509Certificate></ds:X509Data></ds:KeyInfo>
+    </md:KeyDescriptor>
+    <md:KeyDescriptor use="signing">
+      <ds:KeyInfo><ds:X509Data><ds:X509Certificate>
+        %s
+      </ds:X509Certificate></ds:X509Data></ds:KeyInfo>
+    </md:KeyDescriptor>
+  </md:IDPSSODescriptor>
+</md:EntityDescriptor>`, base64.StdEncoding.EncodeToString(certificate.Raw))
+
+	parsed, err := helpers.ParseSAMLMetadata(metadata)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if parsed.EntityID != "https://idp.example.com/metadata" {
+		t.Errorf("unexpected entity ID %q", parsed.EntityID)
+	}
+
+	if len(parsed.SigningCertificates) != 1 || parsed.SigningCertificates[0].SerialNumber.Cmp(certificate.SerialNumber) != 0 {
+		t.Errorf("expected the signing certificate, got %v", parsed.SigningCertificates)
+	}
+
+	for _, invalid := range []string{
+		"not xml",
+		`<EntityDescriptor entityID="https://idp.example.com"></EntityDescriptor>`,
+		`<EntityDescriptor entityID="https://idp.example.com"><IDPSSODescriptor></IDPSSODescriptor></EntityDescriptor>`,
+		`<SPSSODescriptor></SPSSODescriptor>`,
+	} {
+		if _, err := helpers.ParseSAMLMetadata(invalid); err == nil {
+			t.Errorf("expected an error for %q", invalid)
+		}
+	}
+}
+
+func TestCheckSAMLCertificates(t *testing.T) {
+	now := time.Now()
+	window := 30 * 24 * time.Hour
+
+	valid := testCertificate(t, now.Add(-time.Hour), now.Add(365*24*time.Hour))
+	expiring := testCertificate(t, now.Add(-time.Hour), now.Add(7*24*time.Hour))
+	expired := testCertificate(t, now.Add(-48*time.Hour), now.Add(-24*time.Hour))
+
+	warnings, err := helpers.CheckSAMLCertificates([]*x509.Certificate{valid}, now, window)
+	if err != nil || len(warnings) != 0 {
+		t.Errorf("expected no warnings, got %v, %v", warnings, err)
+	}
+
+	warnings, err = helpers.CheckSAMLCertificates([]*x509.Certificate{expiring}, now, window)
+	if err != nil || len(warnings) != 1 {
+		t.Errorf("expected one warning, got %v, %v", warnings, err)
+	}
+
+	warnings, err = helpers.CheckSAMLCertificates([]*x509.Certificate{valid, expired}, now, window)
+	if err != nil || len(warnings) != 1 {
+		t.Errorf("expected a warning for the expired certificate during rollover, got %v, %v", warnings, err)
+	}
+
+	if _, err := helpers.CheckSAMLCertificates([]*x509.Certificate{expired}, now, window); err == nil {
+		t.Error("expected an error when all certificates expired")
+	}
+}
+
+func testCertificate(t *testing.T, notBefore, notAfter time.Time) *x509.Certificate {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: "idp.example.com"},
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certificate, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return certificate
+}
@@ -1,6 +1,14 @@
 package helpers
 
-import "github.com/hashicorp/terraform-plugin-framework/types"
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
 
 func StringOrNil(value string) types.String {
 	if value == "" {
@@ -8,3 +16,59 @@ func StringOrNil(value string) types.String {
 	}
 	return types.StringValue(value)
 }
+
+// ContentID returns a short identifier derived from the content a resource
+// manages, for resources that have no ID of their own in Ory.
+func ContentID(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])[:12]
+}
+
+// DurationOrState returns the duration read from the API, keeping the value in
+// state when both describe the same duration so "24h" and "24h0m0s" don't
+// produce a difference.
+func DurationOrState(value string, state types.String) types.String {
+	if !state.IsNull() && !state.IsUnknown() {
+		stateDuration, stateErr := time.ParseDuration(state.ValueString())
+		valueDuration, valueErr := time.ParseDuration(value)
+
+		if stateErr == nil && valueErr == nil && stateDuration == valueDuration {
+			return state
+		}
+	}
+
+	return StringOrNil(value)
+}
+
+// TimestampOrState returns the RFC 3339 timestamp read from the API, keeping
+// the value in state when both describe the same instant.
+func TimestampOrState(value *time.Time, state types.String) types.String {
+	if value == nil || value.IsZero() {
+		return types.StringNull()
+	}
+
+	if !state.IsNull() && !state.IsUnknown() {
+		stateTime, err := time.Parse(time.RFC3339, state.ValueString())
+		if err == nil && stateTime.Equal(*value) {
+			return state
+		}
+	}
+
+	return types.StringValue(value.Format(time.RFC3339))
+}
+
+// QueryParamsToTf converts query parameters into a map of lists, keeping every
+// value of repeated parameters.
+func QueryParamsToTf(params url.Values) types.Map {
+	queryParams := make(map[string]attr.Value, len(params))
+	for key, values := range params {
+		elements := make([]attr.Value, 0, len(values))
+		for _, value := range values {
+			elements = append(elements, types.StringValue(value))
+		}
+
+		queryParams[key] = types.ListValueMust(types.StringType, elements)
+	}
+
+	return types.MapValueMust(types.ListType{ElemType: types.StringType}, queryParams)
+}
@@ -0,0 +1,127 @@
+package jsonpatch
+
+import (
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ory/client-go"
+)
+
+// Diff returns the RFC 6902 operations that transform from into to. Both
+// documents are expected to be decoded with encoding/json into interface{}.
+// Operations are emitted in a deterministic order, recursing into objects and
+// arrays so only the values that changed are touched.
+func Diff(from, to interface{}) []client.JsonPatch {
+	return DiffAt("", from, to)
+}
+
+// DiffAt works like Diff, but prefixes every operation path with the JSON
+// pointer of the compared documents within a larger document.
+func DiffAt(pointer string, from, to interface{}) []client.JsonPatch {
+	return diff(pointer, from, to, []client.JsonPatch{})
+}
+
+// AddWithParents returns the operations adding the value at the JSON pointer.
+// An "add" fails when the parent doesn't exist, so missing parents are added
+// as empty objects first.
+func AddWithParents(document interface{}, pointer string, value interface{}) []client.JsonPatch {
+	patch := []client.JsonPatch{}
+
+	// The escaped tokens are kept, so prefixes are valid pointers themselves
+	tokens := strings.Split(pointer, "/")
+	for i := 2; i < len(tokens); i++ {
+		parent := strings.Join(tokens[:i], "/")
+
+		if _, found := Get(document, parent); !found {
+			patch = append(patch, client.JsonPatch{Op: "add", Path: parent, Value: map[string]interface{}{}})
+		}
+	}
+
+	return append(patch, client.JsonPatch{Op: "add", Path: pointer, Value: value})
+}
+
+// EscapePointerToken escapes a single reference token of a JSON pointer as
+// defined by RFC 6901.
+func EscapePointerToken(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
+
+func diff(pointer string, from, to interface{}, patch []client.JsonPatch) []client.JsonPatch {
+	if reflect.DeepEqual(from, to) {
+		return patch
+	}
+
+	switch fromValue := from.(type) {
+	case map[string]interface{}:
+		if toValue, ok := to.(map[string]interface{}); ok {
+			return diffObjects(pointer, fromValue, toValue, patch)
+		}
+	case []interface{}:
+		if toValue, ok := to.([]interface{}); ok {
+			return diffArrays(pointer, fromValue, toValue, patch)
+		}
+	}
+
+	// The patch model drops null values, so nulling a value removes it
+	if to == nil {
+		return append(patch, client.JsonPatch{Op: "remove", Path: pointer})
+	}
+
+	return append(patch, client.JsonPatch{Op: "replace", Path: pointer, Value: to})
+}
+
+func diffObjects(pointer string, from, to map[string]interface{}, patch []client.JsonPatch) []client.JsonPatch {
+	for _, key := range sortedKeys(from) {
+		if _, ok := to[key]; !ok {
+			patch = append(patch, client.JsonPatch{Op: "remove", Path: pointer + "/" + EscapePointerToken(key)})
+		}
+	}
+
+	for _, key := range sortedKeys(to) {
+		childPointer := pointer + "/" + EscapePointerToken(key)
+		fromValue, ok := from[key]
+
+		if !ok {
+			if to[key] != nil {
+				patch = append(patch, client.JsonPatch{Op: "add", Path: childPointer, Value: to[key]})
+			}
+			continue
+		}
+
+		patch = diff(childPointer, fromValue, to[key], patch)
+	}
+
+	return patch
+}
+
+func diffArrays(pointer string, from, to []interface{}, patch []client.JsonPatch) []client.JsonPatch {
+	common := min(len(from), len(to))
+
+	for i := 0; i < common; i++ {
+		patch = diff(pointer+"/"+strconv.Itoa(i), from[i], to[i], patch)
+	}
+
+	// Remove from the end so the remaining indexes stay valid
+	for i := len(from) - 1; i >= common; i-- {
+		patch = append(patch, client.JsonPatch{Op: "remove", Path: pointer + "/" + strconv.Itoa(i)})
+	}
+
+	for i := common; i < len(to); i++ {
+		patch = append(patch, client.JsonPatch{Op: "add", Path: pointer + "/" + strconv.Itoa(i), Value: to[i]})
+	}
+
+	return patch
+}
+
+func sortedKeys(object map[string]interface{}) []string {
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,142 @@
+package jsonpatch_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/kibblator/terraform-provider-ory/internal/provider/jsonpatch"
+	"github.com/ory/client-go"
+)
+
+func TestDiff(t *testing.T) {
+	cases := []struct {
+		name     string
+		from     string
+		to       string
+		expected []client.JsonPatch
+	}{
+		{
+			name:     "equal documents",
+			from:     `{"a": 1, "b": [1, 2]}`,
+			to:       `{"b": [1, 2], "a": 1}`,
+			expected: []client.JsonPatch{},
+		},
+		{
+			name: "object members",
+			from: `{"keep": true, "change": "old", "drop": 1}`,
+			to:   `{"keep": true, "change": "new", "new": {"x": 1}}`,
+			expected: []client.JsonPatch{
+				{Op: "remove", Path: "/drop"},
+				{Op: "replace", Path: "/change", Value: "new"},
+				{Op: "add", Path: "/new", Value: map[string]interface{}{"x": float64(1)}},
+			},
+		},
+		{
+			name: "nested objects",
+			from: `{"services": {"identity": {"config": {"session": {"lifespan": "24h"}}}}}`,
+			to:   `{"services": {"identity": {"config": {"session": {"lifespan": "48h"}}}}}`,
+			expected: []client.JsonPatch{
+				{Op: "replace", Path: "/services/identity/config/session/lifespan", Value: "48h"},
+			},
+		},
+		{
+			name: "growing array",
+			from: `{"urls": ["a"]}`,
+			to:   `{"urls": ["a", "b", "c"]}`,
+			expected: []client.JsonPatch{
+				{Op: "add", Path: "/urls/1", Value: "b"},
+				{Op: "add", Path: "/urls/2", Value: "c"},
+			},
+		},
+		{
+			name: "shrinking array",
+			from: `{"urls": ["a", "b", "c"]}`,
+			to:   `{"urls": ["x"]}`,
+			expected: []client.JsonPatch{
+				{Op: "replace", Path: "/urls/0", Value: "x"},
+				{Op: "remove", Path: "/urls/2"},
+				{Op: "remove", Path: "/urls/1"},
+			},
+		},
+		{
+			name: "type change",
+			from: `{"value": {"a": 1}}`,
+			to:   `{"value": [1]}`,
+			expected: []client.JsonPatch{
+				{Op: "replace", Path: "/value", Value: []interface{}{float64(1)}},
+			},
+		},
+		{
+			name: "escaped keys",
+			from: `{}`,
+			to:   `{"a/b": 1, "c~d": 2}`,
+			expected: []client.JsonPatch{
+				{Op: "add", Path: "/a~1b", Value: float64(1)},
+				{Op: "add", Path: "/c~0d", Value: float64(2)},
+			},
+		},
+		{
+			name: "null values",
+			from: `{"a": 1}`,
+			to:   `{"a": null, "b": null}`,
+			expected: []client.JsonPatch{
+				{Op: "remove", Path: "/a"},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var from, to interface{}
+			if err := json.Unmarshal([]byte(c.from), &from); err != nil {
+				t.Fatal(err)
+			}
+			if err := json.Unmarshal([]byte(c.to), &to); err != nil {
+				t.Fatal(err)
+			}
+
+			actual := jsonpatch.Diff(from, to)
+			if !reflect.DeepEqual(actual, c.expected) {
+				t.Errorf("expected %#v, got %#v", c.expected, actual)
+			}
+		})
+	}
+}
+
+func TestDiffAt(t *testing.T) {
+	actual := jsonpatch.DiffAt("/services/identity/config", map[string]interface{}{"a": "old"}, map[string]interface{}{"a": "new"})
+	expected := []client.JsonPatch{
+		{Op: "replace", Path: "/services/identity/config/a", Value: "new"},
+	}
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %#v, got %#v", expected, actual)
+	}
+}
+
+func TestAddWithParents(t *testing.T) {
+	document := map[string]interface{}{
+		"services": map[string]interface{}{"identity": map[string]interface{}{}},
+	}
+
+	actual := jsonpatch.AddWithParents(document, "/services/identity/config/a~1b/c", true)
+	expected := []client.JsonPatch{
+		{Op: "add", Path: "/services/identity/config", Value: map[string]interface{}{}},
+		{Op: "add", Path: "/services/identity/config/a~1b", Value: map[string]interface{}{}},
+		{Op: "add", Path: "/services/identity/config/a~1b/c", Value: true},
+	}
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %#v, got %#v", expected, actual)
+	}
+
+	actual = jsonpatch.AddWithParents(document, "/services/identity", "value")
+	expected = []client.JsonPatch{
+		{Op: "add", Path: "/services/identity", Value: "value"},
+	}
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %#v, got %#v", expected, actual)
+	}
+}
@@ -0,0 +1,68 @@
+package jsonpatch
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParsePointer splits an RFC 6901 JSON pointer into its unescaped reference
+// tokens. The empty pointer refers to the whole document.
+func ParsePointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return []string{}, nil
+	}
+
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("JSON pointer %q must start with a slash", pointer)
+	}
+
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		if strings.Contains(strings.ReplaceAll(strings.ReplaceAll(token, "~0", ""), "~1", ""), "~") {
+			return nil, fmt.Errorf("JSON pointer %q contains an invalid escape sequence", pointer)
+		}
+
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+	}
+
+	return tokens, nil
+}
+
+// Get returns the value the JSON pointer refers to within the document, and
+// whether it exists.
+func Get(document interface{}, pointer string) (interface{}, bool) {
+	tokens, err := ParsePointer(pointer)
+	if err != nil {
+		return nil, false
+	}
+
+	current := document
+
+	for _, token := range tokens {
+		switch value := current.(type) {
+		case map[string]interface{}:
+			child, ok := value[token]
+			if !ok {
+				return nil, false
+			}
+			current = child
+		case []interface{}:
+			index, err := strconv.Atoi(token)
+			if err != nil || index < 0 || index >= len(value) {
+				return nil, false
+			}
+			current = value[index]
+		default:
+			return nil, false
+		}
+	}
+
+	return current, true
+}
+
+// Overlaps reports whether one pointer refers to the same value as, or a
+// value nested within, the other.
+func Overlaps(a, b string) bool {
+	return a == b || strings.HasPrefix(a, b+"/") || strings.HasPrefix(b, a+"/")
+}
@@ -0,0 +1,72 @@
+package jsonpatch_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/kibblator/terraform-provider-ory/internal/provider/jsonpatch"
+)
+
+func TestParsePointer(t *testing.T) {
+	tokens, err := jsonpatch.ParsePointer("/services/a~1b/c~0d/0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"services", "a/b", "c~d", "0"}
+	if !reflect.DeepEqual(tokens, expected) {
+		t.Errorf("expected %v, got %v", expected, tokens)
+	}
+
+	for _, invalid := range []string{"services", "/a~2b", "/a~"} {
+		if _, err := jsonpatch.ParsePointer(invalid); err == nil {
+			t.Errorf("expected an error for %q", invalid)
+		}
+	}
+}
+
+func TestGet(t *testing.T) {
+	var document interface{}
+	if err := json.Unmarshal([]byte(`{"services": {"identity": {"config": {"urls": ["a", "b"], "a/b": true}}}}`), &document); err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		pointer  string
+		expected interface{}
+		found    bool
+	}{
+		{pointer: "/services/identity/config/urls/1", expected: "b", found: true},
+		{pointer: "/services/identity/config/a~1b", expected: true, found: true},
+		{pointer: "/services/identity/config/urls/2", found: false},
+		{pointer: "/services/identity/config/missing", found: false},
+		{pointer: "/services/identity/config/urls/1/nested", found: false},
+	}
+
+	for _, c := range cases {
+		actual, found := jsonpatch.Get(document, c.pointer)
+		if found != c.found || !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("%s: expected (%v, %v), got (%v, %v)", c.pointer, c.expected, c.found, actual, found)
+		}
+	}
+}
+
+func TestOverlaps(t *testing.T) {
+	cases := []struct {
+		a, b     string
+		expected bool
+	}{
+		{a: "/services/identity", b: "/services/identity", expected: true},
+		{a: "/services/identity", b: "/services/identity/config", expected: true},
+		{a: "/services/identity/config/courier", b: "/services/identity", expected: true},
+		{a: "/services/identity/config/courier", b: "/services/identity/config/courier_other", expected: false},
+		{a: "/services/oauth2", b: "/services/identity", expected: false},
+	}
+
+	for _, c := range cases {
+		if actual := jsonpatch.Overlaps(c.a, c.b); actual != c.expected {
+			t.Errorf("Overlaps(%q, %q): expected %v, got %v", c.a, c.b, c.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package jsonpatch
+
+// Project returns the parts of document that are present in at least one of
+// the shapes. Objects are filtered member by member and arrays item by item,
+// any other value is kept as a whole. Array items without a counterpart in the
+// shapes are kept as a whole, so added items still show as changes. It is used
+// to ignore values filled in by the server that the practitioner never
+// configured.
+func Project(document interface{}, shapes ...interface{}) interface{} {
+	switch value := document.(type) {
+	case map[string]interface{}:
+		return projectObject(value, shapes)
+	case []interface{}:
+		return projectArray(value, shapes)
+	default:
+		return document
+	}
+}
+
+func projectObject(object map[string]interface{}, shapes []interface{}) interface{} {
+	projected := map[string]interface{}{}
+
+	for key, value := range object {
+		childShapes := []interface{}{}
+		inShape := false
+
+		for _, shape := range shapes {
+			shapeObject, ok := shape.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			if childShape, ok := shapeObject[key]; ok {
+				inShape = true
+				childShapes = append(childShapes, childShape)
+			}
+		}
+
+		if !inShape {
+			continue
+		}
+
+		projected[key] = projectChild(value, childShapes)
+	}
+
+	return projected
+}
+
+func projectArray(array []interface{}, shapes []interface{}) interface{} {
+	projected := make([]interface{}, len(array))
+
+	for i, item := range array {
+		itemShapes := []interface{}{}
+
+		for _, shape := range shapes {
+			if shapeArray, ok := shape.([]interface{}); ok && i < len(shapeArray) {
+				itemShapes = append(itemShapes, shapeArray[i])
+			}
+		}
+
+		projected[i] = projectChild(item, itemShapes)
+	}
+
+	return projected
+}
+
+// projectChild only recurses when one of the shapes has structure, a scalar
+// shape keeps the whole value.
+func projectChild(value interface{}, shapes []interface{}) interface{} {
+	if !containsContainer(shapes) {
+		return value
+	}
+
+	return Project(value, shapes...)
+}
+
+func containsContainer(values []interface{}) bool {
+	for _, value := range values {
+		switch value.(type) {
+		case map[string]interface{}, []interface{}:
+			return true
+		}
+	}
+
+	return false
+}
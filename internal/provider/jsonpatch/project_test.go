@@ -0,0 +1,79 @@
+package jsonpatch_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/kibblator/terraform-provider-ory/internal/provider/jsonpatch"
+)
+
+func TestProject(t *testing.T) {
+	cases := []struct {
+		name     string
+		document string
+		shapes   []string
+		expected string
+	}{
+		{
+			name:     "server defaults are dropped",
+			document: `{"session": {"lifespan": "24h", "cookie": {"same_site": "Lax"}}, "courier": {"smtp": {}}}`,
+			shapes:   []string{`{"session": {"lifespan": "1h"}}`},
+			expected: `{"session": {"lifespan": "24h"}}`,
+		},
+		{
+			name:     "arrays of scalars and scalars are kept whole",
+			document: `{"urls": ["a", "b"], "session": {"lifespan": "24h"}}`,
+			shapes:   []string{`{"urls": [], "session": "anything"}`},
+			expected: `{"urls": ["a", "b"], "session": {"lifespan": "24h"}}`,
+		},
+		{
+			name:     "array items are filtered item by item",
+			document: `{"hooks": [{"hook": "session", "config": {}}, {"hook": "web_hook", "config": {"url": "https://example.com"}}]}`,
+			shapes:   []string{`{"hooks": [{"hook": "session"}, {"hook": "web_hook", "config": {"url": ""}}]}`},
+			expected: `{"hooks": [{"hook": "session"}, {"hook": "web_hook", "config": {"url": "https://example.com"}}]}`,
+		},
+		{
+			name:     "array items without a shape are kept whole",
+			document: `{"hooks": [{"hook": "session", "config": {}}, {"hook": "revoke_active_sessions", "config": {}}]}`,
+			shapes:   []string{`{"hooks": [{"hook": "session"}]}`},
+			expected: `{"hooks": [{"hook": "session"}, {"hook": "revoke_active_sessions", "config": {}}]}`,
+		},
+		{
+			name:     "shapes are merged",
+			document: `{"a": {"x": 1, "y": 2, "z": 3}, "b": 4, "c": 5}`,
+			shapes:   []string{`{"a": {"x": 0}}`, `{"a": {"y": 0}, "b": 0}`},
+			expected: `{"a": {"x": 1, "y": 2}, "b": 4}`,
+		},
+		{
+			name:     "missing values stay missing",
+			document: `{}`,
+			shapes:   []string{`{"a": {"b": 1}}`},
+			expected: `{}`,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var document, expected interface{}
+			if err := json.Unmarshal([]byte(c.document), &document); err != nil {
+				t.Fatal(err)
+			}
+			if err := json.Unmarshal([]byte(c.expected), &expected); err != nil {
+				t.Fatal(err)
+			}
+
+			shapes := make([]interface{}, len(c.shapes))
+			for i, shape := range c.shapes {
+				if err := json.Unmarshal([]byte(shape), &shapes[i]); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			actual := jsonpatch.Project(document, shapes...)
+			if !reflect.DeepEqual(actual, expected) {
+				t.Errorf("expected %#v, got %#v", expected, actual)
+			}
+		})
+	}
+}
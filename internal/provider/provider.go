@@ -7,6 +7,7 @@ import (
 	"sync"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -14,15 +15,40 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	oryclient "github.com/kibblator/terraform-provider-ory/internal/provider/clients"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/data_sources/identities_data_source"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/data_sources/identity_data_source"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/data_sources/workspace_projects_data_source"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/functions"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/resources/allowed_return_urls_resource"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/resources/cors_resource"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/resources/custom_domain_resource"
 	"github.com/kibblator/terraform-provider-ory/internal/provider/resources/email_configuration_resource"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/resources/event_stream_resource"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/resources/identity_import_resource"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/resources/identity_resource"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/resources/json_web_key_set_resource"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/resources/oauth2_client_resource"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/resources/oauth2_settings_resource"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/resources/organization_resource"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/resources/organization_sso_provider_resource"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/resources/permission_namespaces_resource"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/resources/project_api_key_resource"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/resources/project_config_path_resource"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/resources/project_config_resource"
 	"github.com/kibblator/terraform-provider-ory/internal/provider/resources/registration_resource"
-
-	openapiclient "github.com/ory/client-go"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/resources/relationship_resource"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/resources/relationships_resource"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/resources/saml_provider_resource"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/resources/session_settings_resource"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/resources/trusted_oauth2_jwt_grant_issuer_resource"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/resources/workspace_invite_resource"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/resources/workspace_member_resource"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ provider.Provider = &oryProvider{}
+	_ provider.Provider              = &oryProvider{}
+	_ provider.ProviderWithFunctions = &oryProvider{}
 )
 
 // New is a helper function to simplify provider server and testing implementation.
@@ -47,6 +73,8 @@ type oryProviderModel struct {
 	Host            types.String `tfsdk:"host"`
 	ProjectId       types.String `tfsdk:"project_id"`
 	WorkSpaceApiKey types.String `tfsdk:"workspace_api_key"`
+	ProjectApiKey   types.String `tfsdk:"project_api_key"`
+	ProjectHost     types.String `tfsdk:"project_host"`
 }
 
 // Metadata returns the provider type name.
@@ -72,6 +100,15 @@ func (p *oryProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *
 				Optional:    true,
 				Sensitive:   true,
 			},
+			"project_api_key": schema.StringAttribute{
+				Description: "Your Ory Network project API key, used for the project admin APIs such as OAuth2 clients. Defaults to the workspace API key. May also be provided with the ORY_PROJECT_API_KEY environment variable.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"project_host": schema.StringAttribute{
+				Description: "Host of the project admin APIs. Defaults to the project slug under `projects.oryapis.com`. May also be provided with the ORY_PROJECT_HOST environment variable.",
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -114,6 +151,22 @@ func (p *oryProvider) Configure(ctx context.Context, req provider.ConfigureReque
 		)
 	}
 
+	if config.ProjectApiKey.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("project_api_key"),
+			"Unknown Ory API Project API Key",
+			"The provider cannot create the Ory API client as there is an unknown configuration value for the Ory API project API key.",
+		)
+	}
+
+	if config.ProjectHost.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("project_host"),
+			"Unknown Ory API Project Host",
+			"The provider cannot create the Ory API client as there is an unknown configuration value for the Ory API project host.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -121,11 +174,15 @@ func (p *oryProvider) Configure(ctx context.Context, req provider.ConfigureReque
 	host := os.Getenv("ORY_HOST")
 	project_id := os.Getenv("ORY_PROJECT_ID")
 	workspace_api_key := os.Getenv("ORY_WORKSPACE_API_KEY")
+	project_api_key := os.Getenv("ORY_PROJECT_API_KEY")
+	project_host := os.Getenv("ORY_PROJECT_HOST")
 
 	tflog.Debug(ctx, "Checking environment variables for Ory configuration", map[string]interface{}{
-		"ory_host":              host,
-		"ory_project_id":        project_id,
-		"ory_workspace_api_key": workspace_api_key,
+		"ory_host":                  host,
+		"ory_project_id":            project_id,
+		"ory_workspace_api_key_set": workspace_api_key != "",
+		"ory_project_api_key_set":   project_api_key != "",
+		"ory_project_host":          project_host,
 	})
 
 	if !config.Host.IsNull() {
@@ -140,6 +197,14 @@ func (p *oryProvider) Configure(ctx context.Context, req provider.ConfigureReque
 		workspace_api_key = config.WorkSpaceApiKey.ValueString()
 	}
 
+	if !config.ProjectApiKey.IsNull() {
+		project_api_key = config.ProjectApiKey.ValueString()
+	}
+
+	if !config.ProjectHost.IsNull() {
+		project_host = config.ProjectHost.ValueString()
+	}
+
 	// If any of the expected configurations are missing, return
 	// errors with provider-specific guidance.
 
@@ -169,18 +234,19 @@ func (p *oryProvider) Configure(ctx context.Context, req provider.ConfigureReque
 		return
 	}
 
+	if project_api_key == "" {
+		project_api_key = workspace_api_key
+	}
+
 	ctx = tflog.SetField(ctx, "ory_host", host)
 	ctx = tflog.SetField(ctx, "ory_project_id", project_id)
 	ctx = tflog.SetField(ctx, "ory_workspace_api_key", workspace_api_key)
-	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "ory_workspace_api_key")
+	ctx = tflog.SetField(ctx, "ory_project_api_key", project_api_key)
+	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "ory_workspace_api_key", "ory_project_api_key")
 
 	tflog.Debug(ctx, "Creating Ory client")
 
 	// Create a new Ory client using the configuration values and pull configuration
-	configuration := openapiclient.NewConfiguration()
-	configuration.Host = host
-	configuration.AddDefaultHeader("Authorization", "Bearer "+workspace_api_key)
-
 	apiClient := oryclient.NewClient(host, workspace_api_key, project_id)
 	response, err := apiClient.GetProject(&sync.Mutex{})
 
@@ -195,10 +261,16 @@ func (p *oryProvider) Configure(ctx context.Context, req provider.ConfigureReque
 		return
 	}
 
+	if project_host == "" {
+		project_host = response.Slug + ".projects.oryapis.com"
+	}
+
 	client := &oryclient.OryClient{
-		APIClient:     apiClient,
-		ProjectConfig: response,
-		ProjectID:     project_id,
+		APIClient:        apiClient,
+		ConsoleAPIClient: oryclient.NewConsoleAPIClient(host, workspace_api_key),
+		ProjectAPIClient: oryclient.NewProjectAPIClient(project_host, project_api_key),
+		ProjectConfig:    response,
+		ProjectID:        project_id,
 	}
 
 	// Make the Ory config available during DataSource and Resource
@@ -211,7 +283,11 @@ func (p *oryProvider) Configure(ctx context.Context, req provider.ConfigureReque
 
 // DataSources defines the data sources implemented in the provider.
 func (p *oryProvider) DataSources(_ context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		identity_data_source.NewIdentityDataSource,
+		identities_data_source.NewIdentitiesDataSource,
+		workspace_projects_data_source.NewWorkspaceProjectsDataSource,
+	}
 }
 
 // Resources defines the resources implemented in the provider.
@@ -219,5 +295,37 @@ func (p *oryProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		registration_resource.NewRegistrationResource,
 		email_configuration_resource.NewEmailConfigurationResource,
+		project_config_path_resource.NewProjectConfigPathResource,
+		project_config_resource.NewProjectConfigResource,
+		session_settings_resource.NewSessionSettingsResource,
+		cors_resource.NewCorsResource,
+		custom_domain_resource.NewCustomDomainResource,
+		allowed_return_urls_resource.NewAllowedReturnURLsResource,
+		oauth2_client_resource.NewOAuth2ClientResource,
+		oauth2_settings_resource.NewOAuth2SettingsResource,
+		permission_namespaces_resource.NewPermissionNamespacesResource,
+		relationship_resource.NewRelationshipResource,
+		relationships_resource.NewRelationshipsResource,
+		identity_resource.NewIdentityResource,
+		identity_import_resource.NewIdentityImportResource,
+		organization_resource.NewOrganizationResource,
+		organization_sso_provider_resource.NewOrganizationSSOProviderResource,
+		saml_provider_resource.NewSAMLProviderResource,
+		project_api_key_resource.NewProjectAPIKeyResource,
+		workspace_invite_resource.NewWorkspaceInviteResource,
+		workspace_member_resource.NewWorkspaceMemberResource,
+		event_stream_resource.NewEventStreamResource,
+		json_web_key_set_resource.NewJsonWebKeySetResource,
+		trusted_oauth2_jwt_grant_issuer_resource.NewTrustedOAuth2JwtGrantIssuerResource,
+	}
+}
+
+// Functions defines the functions implemented in the provider.
+func (p *oryProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		functions.NewSmtpUriFunction,
+		functions.NewParseSmtpUriFunction,
+		functions.NewJsonnetBodyFunction,
+		functions.NewJsonPatchFunction,
 	}
 }
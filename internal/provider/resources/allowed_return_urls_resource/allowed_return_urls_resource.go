@@ -0,0 +1,323 @@
+package allowed_return_urls_resource
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	oryclient "github.com/kibblator/terraform-provider-ory/internal/provider/clients"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/custom_validators"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/helpers"
+	orytypes "github.com/kibblator/terraform-provider-ory/internal/provider/types"
+)
+
+const (
+	modeAuthoritative = "authoritative"
+	modeAdditive      = "additive"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &allowedReturnURLsResource{}
+	_ resource.ResourceWithConfigure      = &allowedReturnURLsResource{}
+	_ resource.ResourceWithImportState    = &allowedReturnURLsResource{}
+	_ resource.ResourceWithValidateConfig = &allowedReturnURLsResource{}
+)
+
+// NewAllowedReturnURLsResource is a helper function to simplify the provider implementation.
+func NewAllowedReturnURLsResource() resource.Resource {
+	return &allowedReturnURLsResource{}
+}
+
+// allowedReturnURLsResource is the resource implementation.
+type allowedReturnURLsResource struct {
+	oryClient *oryclient.OryClient
+}
+
+// allowedReturnURLsResourceModel maps the resource schema data.
+type allowedReturnURLsResourceModel struct {
+	ID                      types.String   `tfsdk:"id"`
+	LastUpdated             types.String   `tfsdk:"last_updated"`
+	Mode                    types.String   `tfsdk:"mode"`
+	URLs                    []types.String `tfsdk:"urls"`
+	DefaultBrowserReturnURL types.String   `tfsdk:"default_browser_return_url"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *allowedReturnURLsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*oryclient.OryClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected OryClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.oryClient = client
+}
+
+// Metadata returns the resource type name.
+func (r *allowedReturnURLsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_allowed_return_urls"
+}
+
+// Schema defines the schema for the resource.
+func (r *allowedReturnURLsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the URLs users may be redirected to after self-service flows. In `authoritative` mode the resource owns the whole list, in `additive` mode it only manages its own URLs so several resources can contribute to the list.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "String identifier of the allowed return URLs resource.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update of the allowed return URLs.",
+				Computed:    true,
+			},
+			"mode": schema.StringAttribute{
+				Description: "Either `authoritative`, replacing the whole list, or `additive`, only adding and removing the URLs of this resource. Defaults to `authoritative`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(modeAuthoritative),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(modeAuthoritative, modeAdditive),
+				},
+			},
+			"urls": schema.SetAttribute{
+				Description: "Allowed return URLs. Glob wildcards are supported, for example `https://*.example.com`.",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(custom_validators.ReturnURLValidator{}),
+				},
+			},
+			"default_browser_return_url": schema.StringAttribute{
+				Description: "URL browsers are redirected to when no return URL is given. Only supported in `authoritative` mode.",
+				Optional:    true,
+				Validators: []validator.String{
+					custom_validators.URLValidator{Schemes: []string{"http", "https"}},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig rejects settings that several additive resources would fight over.
+func (r *allowedReturnURLsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config allowedReturnURLsResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Mode.ValueString() == modeAdditive && !config.DefaultBrowserReturnURL.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("default_browser_return_url"),
+			"Unsupported attribute in additive mode",
+			"The default browser return URL can only be managed in authoritative mode.",
+		)
+	}
+}
+
+// Create a new resource.
+func (r *allowedReturnURLsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan allowedReturnURLsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.apply(plan, nil)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating ory allowed return URLs",
+			"Could not create ory allowed return URLs, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.StringValue("allowed_return_urls")
+	if plan.Mode.ValueString() == modeAdditive {
+		plan.ID = types.StringValue("allowed_return_urls:" + helpers.ContentID(strings.Join(urlsToApi(plan.URLs), ",")))
+	}
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *allowedReturnURLsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading allowed return URLs resource")
+
+	// Retrieve current state
+	var state allowedReturnURLsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Fetch current project configuration from ORY
+	project, err := r.oryClient.APIClient.GetProject(&r.oryClient.Mutex)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error fetching ORY allowed return URLs",
+			"Could not retrieve ORY allowed return URLs: "+err.Error(),
+		)
+		return
+	}
+
+	selfService := project.Services.Identity.Config.SelfService
+	if selfService == nil {
+		selfService = &orytypes.SelfService{}
+	}
+
+	if state.Mode.IsNull() {
+		state.Mode = types.StringValue(modeAuthoritative)
+	}
+
+	ApiToAllowedReturnURLs(selfService, &state)
+
+	// Set the updated state
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *allowedReturnURLsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve the desired state from the plan
+	var plan, state allowedReturnURLsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.apply(plan, &state)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating ory allowed return URLs",
+			"Could not update ory allowed return URLs, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set the updated plan to the state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete removes the URLs of additive resources, authoritative resources leave
+// the list unchanged.
+func (r *allowedReturnURLsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state allowedReturnURLsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.Mode.ValueString() != modeAdditive {
+		return
+	}
+
+	removal := state
+	removal.URLs = []types.String{}
+
+	err := r.apply(removal, &state)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting ory allowed return URLs",
+			"Could not delete ory allowed return URLs, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *allowedReturnURLsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// apply patches the allowed return URLs from the previous state to the plan.
+func (r *allowedReturnURLsResource) apply(plan allowedReturnURLsResourceModel, state *allowedReturnURLsResourceModel) error {
+	project, err := r.oryClient.APIClient.GetProject(&r.oryClient.Mutex)
+	if err != nil {
+		return err
+	}
+
+	var live []string
+	if project.Services.Identity.Config.SelfService != nil {
+		live = project.Services.Identity.Config.SelfService.AllowedReturnURLs
+	}
+
+	patch := AllowedReturnURLsToApi(live, plan, state)
+
+	if len(patch) == 0 {
+		return nil
+	}
+
+	_, err = r.oryClient.APIClient.PatchProject(project.RevisionId, patch, &r.oryClient.Mutex)
+
+	return err
+}
+
+func urlsToApi(urls []types.String) []string {
+	apiURLs := make([]string, 0, len(urls))
+	for _, url := range urls {
+		apiURLs = append(apiURLs, url.ValueString())
+	}
+
+	sort.Strings(apiURLs)
+
+	return apiURLs
+}
@@ -0,0 +1,70 @@
+package allowed_return_urls_resource_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/kibblator/terraform-provider-ory/internal/provider/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOryAllowedReturnURLsResource(t *testing.T) {
+	randomName := acctest.GenerateRandomResourceName()
+	resourceName := fmt.Sprintf("ory_allowed_return_urls.%s", randomName)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			// Invalid globs are rejected at plan time
+			{
+				Config: fmt.Sprintf(`
+resource "ory_allowed_return_urls" "%s" {
+  urls = ["https://[example.com"]
+}
+`, randomName),
+				ExpectError: regexp.MustCompile("Invalid Return URL"),
+			},
+			// Create and Read testing
+			{
+				Config: fmt.Sprintf(`
+resource "ory_allowed_return_urls" "%s" {
+  urls                       = ["https://app.example.com", "https://*.example.com"]
+  default_browser_return_url = "https://app.example.com/welcome"
+}
+`, randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "mode", "authoritative"),
+					resource.TestCheckResourceAttr(resourceName, "urls.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "urls.*", "https://*.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "default_browser_return_url", "https://app.example.com/welcome"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"last_updated", // If 'last_updated' isn't returned from the ORY API, ignore it during import verification
+				},
+			},
+			// Additive mode only manages its own URLs
+			{
+				Config: fmt.Sprintf(`
+resource "ory_allowed_return_urls" "%s_additive" {
+  mode = "additive"
+  urls = ["https://frontend.example.com"]
+}
+`, randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName+"_additive", "mode", "additive"),
+					resource.TestCheckResourceAttr(resourceName+"_additive", "urls.#", "1"),
+					resource.TestCheckResourceAttr(resourceName+"_additive", "urls.0", "https://frontend.example.com"),
+				),
+			},
+		},
+	})
+}
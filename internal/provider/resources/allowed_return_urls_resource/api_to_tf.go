@@ -0,0 +1,33 @@
+package allowed_return_urls_resource
+
+import (
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/helpers"
+	orytypes "github.com/kibblator/terraform-provider-ory/internal/provider/types"
+)
+
+// ApiToAllowedReturnURLs maps the live allowed return URLs onto the model.
+// Additive resources only track which of their own URLs are still present.
+func ApiToAllowedReturnURLs(selfService *orytypes.SelfService, tfConfig *allowedReturnURLsResourceModel) {
+	urls := []types.String{}
+
+	if tfConfig.Mode.ValueString() == modeAdditive {
+		for _, url := range tfConfig.URLs {
+			if slices.Contains(selfService.AllowedReturnURLs, url.ValueString()) {
+				urls = append(urls, url)
+			}
+		}
+	} else {
+		for _, url := range selfService.AllowedReturnURLs {
+			urls = append(urls, types.StringValue(url))
+		}
+
+		if !tfConfig.DefaultBrowserReturnURL.IsNull() || tfConfig.URLs == nil {
+			tfConfig.DefaultBrowserReturnURL = helpers.StringOrNil(selfService.DefaultBrowserReturnURL)
+		}
+	}
+
+	tfConfig.URLs = urls
+}
@@ -0,0 +1,59 @@
+package allowed_return_urls_resource
+
+import (
+	"slices"
+
+	"github.com/ory/client-go"
+)
+
+// AllowedReturnURLsToApi returns the patch turning the live allowed return URLs
+// into the planned ones. Authoritative resources replace the whole list,
+// additive resources only add missing URLs and remove the ones they dropped.
+func AllowedReturnURLsToApi(live []string, plan allowedReturnURLsResourceModel, state *allowedReturnURLsResourceModel) []client.JsonPatch {
+	var patch []client.JsonPatch
+
+	planned := urlsToApi(plan.URLs)
+	urls := planned
+
+	if plan.Mode.ValueString() == modeAdditive {
+		removed := []string{}
+		if state != nil {
+			for _, url := range urlsToApi(state.URLs) {
+				if !slices.Contains(planned, url) {
+					removed = append(removed, url)
+				}
+			}
+		}
+
+		urls = []string{}
+		for _, url := range live {
+			if !slices.Contains(removed, url) && !slices.Contains(urls, url) {
+				urls = append(urls, url)
+			}
+		}
+
+		for _, url := range planned {
+			if !slices.Contains(urls, url) {
+				urls = append(urls, url)
+			}
+		}
+	}
+
+	if !slices.Equal(live, urls) {
+		patch = append(patch, client.JsonPatch{
+			Op:    "add",
+			Path:  "/services/identity/config/selfservice/allowed_return_urls",
+			Value: urls,
+		})
+	}
+
+	if !plan.DefaultBrowserReturnURL.IsNull() {
+		patch = append(patch, client.JsonPatch{
+			Op:    "add",
+			Path:  "/services/identity/config/selfservice/default_browser_return_url",
+			Value: plan.DefaultBrowserReturnURL.ValueString(),
+		})
+	}
+
+	return patch
+}
@@ -0,0 +1,47 @@
+package cors_resource
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/custom_validators"
+	orytypes "github.com/kibblator/terraform-provider-ory/internal/provider/types"
+)
+
+// ApiToCorsConfig maps live CORS settings onto the model, keeping the form of
+// origins in state that only differ by a trailing slash.
+func ApiToCorsConfig(cors *orytypes.ProjectCors, previous *CorsConfig) *CorsConfig {
+	if cors == nil {
+		cors = &orytypes.ProjectCors{}
+	}
+
+	tfConfig := &CorsConfig{
+		Enabled: types.BoolValue(cors.Enabled),
+	}
+
+	stateForms := map[string]types.String{}
+	if previous != nil {
+		for _, origin := range previous.Origins {
+			stateForms[custom_validators.NormalizeOrigin(origin.ValueString())] = origin
+		}
+	}
+
+	if len(cors.Origins) == 0 {
+		if previous != nil && previous.Origins != nil {
+			tfConfig.Origins = []types.String{}
+		}
+
+		return tfConfig
+	}
+
+	tfConfig.Origins = []types.String{}
+
+	for _, origin := range cors.Origins {
+		if stateForm, ok := stateForms[custom_validators.NormalizeOrigin(origin)]; ok {
+			tfConfig.Origins = append(tfConfig.Origins, stateForm)
+			continue
+		}
+
+		tfConfig.Origins = append(tfConfig.Origins, types.StringValue(origin))
+	}
+
+	return tfConfig
+}
@@ -0,0 +1,261 @@
+package cors_resource
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	oryclient "github.com/kibblator/terraform-provider-ory/internal/provider/clients"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/custom_validators"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &corsResource{}
+	_ resource.ResourceWithConfigure   = &corsResource{}
+	_ resource.ResourceWithImportState = &corsResource{}
+)
+
+// NewCorsResource is a helper function to simplify the provider implementation.
+func NewCorsResource() resource.Resource {
+	return &corsResource{}
+}
+
+// corsResource is the resource implementation.
+type corsResource struct {
+	oryClient *oryclient.OryClient
+}
+
+type CorsConfig struct {
+	Enabled types.Bool     `tfsdk:"enabled"`
+	Origins []types.String `tfsdk:"origins"`
+}
+
+// corsResourceModel maps the resource schema data.
+type corsResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	LastUpdated types.String `tfsdk:"last_updated"`
+	Public      *CorsConfig  `tfsdk:"public"`
+	Admin       *CorsConfig  `tfsdk:"admin"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *corsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*oryclient.OryClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected OryClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.oryClient = client
+}
+
+// Metadata returns the resource type name.
+func (r *corsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cors"
+}
+
+func corsConfigSchema(api string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: fmt.Sprintf("CORS settings of the %s API.", api),
+		Optional:    true,
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				Description: fmt.Sprintf("If enabled, CORS requests to the %s API are allowed from the configured origins.", api),
+				Required:    true,
+			},
+			"origins": schema.ListAttribute{
+				Description: "Allowed origins such as `https://example.com`. A single wildcard is allowed in the host, for example `https://*.example.com`. Trailing slashes are ignored.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					custom_validators.UniqueOriginsValidator{},
+					listvalidator.ValueStringsAre(custom_validators.OriginValidator{}),
+				},
+			},
+		},
+	}
+}
+
+// Schema defines the schema for the resource.
+func (r *corsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "String identifier of the CORS resource.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update of the CORS settings.",
+				Computed:    true,
+			},
+			"public": corsConfigSchema("public"),
+			"admin":  corsConfigSchema("admin"),
+		},
+	}
+}
+
+// Create a new resource.
+func (r *corsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan corsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	patch := CorsToApi(plan)
+
+	project, err := r.oryClient.APIClient.GetProject(&r.oryClient.Mutex)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error fetching ORY CORS settings",
+			"Could not retrieve ORY CORS settings: "+err.Error(),
+		)
+		return
+	}
+
+	_, err = r.oryClient.APIClient.PatchProject(project.RevisionId, patch, &r.oryClient.Mutex)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating ory CORS settings",
+			"Could not create ory CORS settings, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.StringValue("cors_settings")
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *corsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading CORS resource")
+
+	// Retrieve current state
+	var state corsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Fetch current project configuration from ORY
+	project, err := r.oryClient.APIClient.GetProject(&r.oryClient.Mutex)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error fetching ORY CORS settings",
+			"Could not retrieve ORY CORS settings: "+err.Error(),
+		)
+		return
+	}
+
+	// Only the blocks under management are refreshed
+	if state.Public != nil {
+		state.Public = ApiToCorsConfig(project.CorsPublic, state.Public)
+	}
+
+	if state.Admin != nil {
+		state.Admin = ApiToCorsConfig(project.CorsAdmin, state.Admin)
+	}
+
+	tflog.Debug(ctx, "Updated State", map[string]interface{}{
+		"state": state,
+	})
+
+	// Set the updated state
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *corsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve the desired state from the plan
+	var plan corsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	patch := CorsToApi(plan)
+
+	project, err := r.oryClient.APIClient.GetProject(&r.oryClient.Mutex)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error fetching ORY CORS settings",
+			"Could not retrieve ORY CORS settings: "+err.Error(),
+		)
+		return
+	}
+
+	_, err = r.oryClient.APIClient.PatchProject(project.RevisionId, patch, &r.oryClient.Mutex)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating ory CORS settings",
+			"Could not update ory CORS settings, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set the updated plan to the state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *corsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+func (r *corsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+
+	// Read only refreshes managed blocks, so take over both of them on import
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("public"), &CorsConfig{Enabled: types.BoolValue(false)})...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("admin"), &CorsConfig{Enabled: types.BoolValue(false)})...)
+}
@@ -0,0 +1,77 @@
+package cors_resource_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/kibblator/terraform-provider-ory/internal/provider/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOryCorsResource(t *testing.T) {
+	randomName := acctest.GenerateRandomResourceName()
+	resourceName := fmt.Sprintf("ory_cors.%s", randomName)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			// Invalid origins are rejected at plan time
+			{
+				Config:      testAccOryCors(randomName, `"https://*.*.example.com"`),
+				ExpectError: regexp.MustCompile("Invalid Origin"),
+			},
+			{
+				Config:      testAccOryCors(randomName, `"https://example.com/path"`),
+				ExpectError: regexp.MustCompile("Invalid Origin"),
+			},
+			{
+				Config:      testAccOryCors(randomName, `"https://example.com", "https://example.com/"`),
+				ExpectError: regexp.MustCompile("Duplicate Origin"),
+			},
+			// Create and Read testing, trailing slashes don't cause a diff
+			{
+				Config: testAccOryCors(randomName, `"https://app.example.com/", "https://*.example.com"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "cors_settings"),
+					resource.TestCheckResourceAttr(resourceName, "public.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "public.origins.0", "https://app.example.com/"),
+					resource.TestCheckResourceAttr(resourceName, "public.origins.1", "https://*.example.com"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_updated"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"last_updated", // If 'last_updated' isn't returned from the ORY API, ignore it during import verification
+					"public.origins.0",
+					"admin",
+				},
+			},
+			// Update and Read testing
+			{
+				Config: testAccOryCors(randomName, `"https://app.example.com"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "public.origins.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "public.origins.0", "https://app.example.com"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOryCors(randomName string, origins string) string {
+	return fmt.Sprintf(`
+resource "ory_cors" "%s" {
+  public = {
+    enabled = true
+    origins = [%s]
+  }
+}
+`, randomName, origins)
+}
@@ -0,0 +1,43 @@
+package cors_resource
+
+import (
+	"github.com/kibblator/terraform-provider-ory/internal/provider/custom_validators"
+	orytypes "github.com/kibblator/terraform-provider-ory/internal/provider/types"
+	"github.com/ory/client-go"
+)
+
+// CorsToApi returns the patch replacing the configured CORS settings.
+func CorsToApi(plan corsResourceModel) []client.JsonPatch {
+	var patch []client.JsonPatch
+
+	if plan.Public != nil {
+		patch = append(patch, client.JsonPatch{
+			Op:    "add",
+			Path:  "/cors_public",
+			Value: CorsConfigToApi(plan.Public),
+		})
+	}
+
+	if plan.Admin != nil {
+		patch = append(patch, client.JsonPatch{
+			Op:    "add",
+			Path:  "/cors_admin",
+			Value: CorsConfigToApi(plan.Admin),
+		})
+	}
+
+	return patch
+}
+
+func CorsConfigToApi(tfConfig *CorsConfig) orytypes.ProjectCors {
+	origins := []string{}
+
+	for _, origin := range tfConfig.Origins {
+		origins = append(origins, custom_validators.NormalizeOrigin(origin.ValueString()))
+	}
+
+	return orytypes.ProjectCors{
+		Enabled: tfConfig.Enabled.ValueBool(),
+		Origins: origins,
+	}
+}
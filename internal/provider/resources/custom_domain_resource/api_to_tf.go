@@ -0,0 +1,33 @@
+package custom_domain_resource
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/helpers"
+	"github.com/ory/client-go"
+)
+
+// ApiToCustomDomain maps the custom domain returned by the API onto the model.
+func ApiToCustomDomain(domain *client.CustomDomain, tfConfig *customDomainResourceModel) {
+	tfConfig.ID = types.StringValue(domain.GetId())
+	tfConfig.Hostname = types.StringValue(domain.GetHostname())
+	tfConfig.CookieDomain = helpers.StringOrNil(domain.GetCookieDomain())
+	tfConfig.CustomUIBaseURL = helpers.StringOrNil(domain.GetCustomUiBaseUrl())
+	tfConfig.CorsEnabled = types.BoolValue(domain.GetCorsEnabled())
+	tfConfig.VerificationStatus = types.StringValue(domain.GetVerificationStatus())
+	tfConfig.SSLStatus = types.StringValue(domain.GetSslStatus())
+
+	verificationErrors := []attr.Value{}
+	for _, verificationError := range domain.VerificationErrors {
+		verificationErrors = append(verificationErrors, types.StringValue(verificationError))
+	}
+	tfConfig.VerificationErrors = types.ListValueMust(types.StringType, verificationErrors)
+
+	if len(domain.CorsAllowedOrigins) > 0 || tfConfig.CorsAllowedOrigins != nil {
+		origins := []types.String{}
+		for _, origin := range domain.CorsAllowedOrigins {
+			origins = append(origins, types.StringValue(origin))
+		}
+		tfConfig.CorsAllowedOrigins = origins
+	}
+}
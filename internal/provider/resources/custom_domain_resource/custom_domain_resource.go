@@ -0,0 +1,382 @@
+package custom_domain_resource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	oryclient "github.com/kibblator/terraform-provider-ory/internal/provider/clients"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/custom_validators"
+	"github.com/ory/client-go"
+)
+
+const (
+	// verifiedStatus is the verification status of a custom domain whose CNAME has been verified.
+	verifiedStatus = "active"
+
+	defaultVerificationTimeout = 10 * time.Minute
+	verificationPollInterval   = 10 * time.Second
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &customDomainResource{}
+	_ resource.ResourceWithConfigure   = &customDomainResource{}
+	_ resource.ResourceWithImportState = &customDomainResource{}
+)
+
+// NewCustomDomainResource is a helper function to simplify the provider implementation.
+func NewCustomDomainResource() resource.Resource {
+	return &customDomainResource{}
+}
+
+// customDomainResource is the resource implementation.
+type customDomainResource struct {
+	oryClient *oryclient.OryClient
+}
+
+// customDomainResourceModel maps the resource schema data.
+type customDomainResourceModel struct {
+	ID                  types.String   `tfsdk:"id"`
+	LastUpdated         types.String   `tfsdk:"last_updated"`
+	Hostname            types.String   `tfsdk:"hostname"`
+	CookieDomain        types.String   `tfsdk:"cookie_domain"`
+	CustomUIBaseURL     types.String   `tfsdk:"custom_ui_base_url"`
+	CorsEnabled         types.Bool     `tfsdk:"cors_enabled"`
+	CorsAllowedOrigins  []types.String `tfsdk:"cors_allowed_origins"`
+	VerificationStatus  types.String   `tfsdk:"verification_status"`
+	VerificationErrors  types.List     `tfsdk:"verification_errors"`
+	SSLStatus           types.String   `tfsdk:"ssl_status"`
+	WaitForVerification types.Bool     `tfsdk:"wait_for_verification"`
+	VerificationTimeout types.String   `tfsdk:"verification_timeout"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *customDomainResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*oryclient.OryClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected OryClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.oryClient = client
+}
+
+// Metadata returns the resource type name.
+func (r *customDomainResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_domain"
+}
+
+// Schema defines the schema for the resource.
+func (r *customDomainResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a custom domain (CNAME) of the project.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the custom domain.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update of the custom domain.",
+				Computed:    true,
+			},
+			"hostname": schema.StringAttribute{
+				Description: "The custom hostname the Ory APIs are exposed on, for example `auth.example.com`.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"cookie_domain": schema.StringAttribute{
+				Description: "The domain cookies are set on. Has to be a parent domain of the hostname.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"custom_ui_base_url": schema.StringAttribute{
+				Description: "The base URL the custom user interface is exposed on.",
+				Optional:    true,
+				Validators: []validator.String{
+					custom_validators.URLValidator{Schemes: []string{"http", "https"}},
+				},
+			},
+			"cors_enabled": schema.BoolAttribute{
+				Description: "If enabled, CORS requests to the custom hostname are allowed from the configured origins.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cors_allowed_origins": schema.ListAttribute{
+				Description: "Allowed CORS origins for the custom hostname.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(custom_validators.OriginValidator{}),
+				},
+			},
+			"verification_status": schema.StringAttribute{
+				Description: "Verification status of the CNAME record, `active` once verified.",
+				Computed:    true,
+			},
+			"verification_errors": schema.ListAttribute{
+				Description: "Errors reported while verifying the CNAME record.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"ssl_status": schema.StringAttribute{
+				Description: "Status of the TLS certificate of the custom hostname.",
+				Computed:    true,
+			},
+			"wait_for_verification": schema.BoolAttribute{
+				Description: "If enabled, applying waits until the CNAME record has been verified.",
+				Optional:    true,
+			},
+			"verification_timeout": schema.StringAttribute{
+				Description: "How long to wait for the verification, as a duration such as `15m`. Defaults to `10m`.",
+				Optional:    true,
+				Validators: []validator.String{
+					custom_validators.DurationValidator{},
+					stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("wait_for_verification")),
+				},
+			},
+		},
+	}
+}
+
+// Create a new resource.
+func (r *customDomainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan customDomainResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := client.CreateCustomDomainBody{
+		Hostname:           plan.Hostname.ValueStringPointer(),
+		CookieDomain:       plan.CookieDomain.ValueStringPointer(),
+		CustomUiBaseUrl:    plan.CustomUIBaseURL.ValueStringPointer(),
+		CorsEnabled:        plan.CorsEnabled.ValueBoolPointer(),
+		CorsAllowedOrigins: originsToApi(plan.CorsAllowedOrigins),
+	}
+
+	domain, err := r.oryClient.APIClient.CreateCustomDomain(body)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating ory custom domain",
+			"Could not create ory custom domain, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Save the ID right away so a failed verification doesn't leak the domain
+	plan.ID = types.StringValue(domain.GetId())
+	ApiToCustomDomain(domain, &plan)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	if plan.WaitForVerification.ValueBool() {
+		domain, err = r.waitForVerification(ctx, domain, plan.VerificationTimeout)
+		ApiToCustomDomain(domain, &plan)
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error verifying ory custom domain",
+				"Could not verify ory custom domain: "+err.Error(),
+			)
+		}
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *customDomainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading custom domain resource")
+
+	// Retrieve current state
+	var state customDomainResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domain, err := r.oryClient.APIClient.GetCustomDomain(state.ID.ValueString())
+
+	if errors.Is(err, oryclient.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error fetching ORY custom domain",
+			"Could not retrieve ORY custom domain: "+err.Error(),
+		)
+		return
+	}
+
+	ApiToCustomDomain(domain, &state)
+
+	// Set the updated state
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *customDomainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve the desired state from the plan
+	var plan customDomainResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body := client.SetCustomDomainBody{
+		Hostname:           plan.Hostname.ValueStringPointer(),
+		CookieDomain:       plan.CookieDomain.ValueStringPointer(),
+		CustomUiBaseUrl:    plan.CustomUIBaseURL.ValueStringPointer(),
+		CorsEnabled:        plan.CorsEnabled.ValueBoolPointer(),
+		CorsAllowedOrigins: originsToApi(plan.CorsAllowedOrigins),
+	}
+
+	domain, err := r.oryClient.APIClient.SetCustomDomain(plan.ID.ValueString(), body)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating ory custom domain",
+			"Could not update ory custom domain, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	ApiToCustomDomain(domain, &plan)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	if plan.WaitForVerification.ValueBool() {
+		domain, err = r.waitForVerification(ctx, domain, plan.VerificationTimeout)
+		ApiToCustomDomain(domain, &plan)
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error verifying ory custom domain",
+				"Could not verify ory custom domain: "+err.Error(),
+			)
+		}
+	}
+
+	// Set the updated plan to the state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *customDomainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state customDomainResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.oryClient.APIClient.DeleteCustomDomain(state.ID.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting ory custom domain",
+			"Could not delete ory custom domain, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *customDomainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// waitForVerification polls the custom domain until its CNAME has been
+// verified or the timeout elapses, returning the last known domain.
+func (r *customDomainResource) waitForVerification(ctx context.Context, domain *client.CustomDomain, timeout types.String) (*client.CustomDomain, error) {
+	waitTimeout := defaultVerificationTimeout
+	if !timeout.IsNull() {
+		waitTimeout, _ = time.ParseDuration(timeout.ValueString())
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, waitTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(verificationPollInterval)
+	defer ticker.Stop()
+
+	for domain.GetVerificationStatus() != verifiedStatus {
+		tflog.Debug(ctx, "Waiting for custom domain verification", map[string]interface{}{
+			"hostname":            domain.GetHostname(),
+			"verification_status": domain.GetVerificationStatus(),
+		})
+
+		select {
+		case <-ctx.Done():
+			message := fmt.Sprintf("custom domain %s was not verified within %s, last status %q", domain.GetHostname(), waitTimeout, domain.GetVerificationStatus())
+			if len(domain.VerificationErrors) > 0 {
+				message += ": " + strings.Join(domain.VerificationErrors, ", ")
+			}
+			return domain, errors.New(message)
+		case <-ticker.C:
+		}
+
+		current, err := r.oryClient.APIClient.GetCustomDomain(domain.GetId())
+		if err != nil {
+			return domain, err
+		}
+
+		domain = current
+	}
+
+	return domain, nil
+}
@@ -0,0 +1,63 @@
+package custom_domain_resource_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kibblator/terraform-provider-ory/internal/provider/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOryCustomDomainResource(t *testing.T) {
+	randomName := acctest.GenerateRandomResourceName()
+	resourceName := fmt.Sprintf("ory_custom_domain.%s", randomName)
+	hostname := fmt.Sprintf("%s.example.com", randomName)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccOryCustomDomain(randomName, hostname, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "hostname", hostname),
+					resource.TestCheckResourceAttr(resourceName, "cookie_domain", "example.com"),
+					resource.TestCheckResourceAttr(resourceName, "cors_enabled", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "verification_status"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"last_updated", // If 'last_updated' isn't returned from the ORY API, ignore it during import verification
+				},
+			},
+			// Update and Read testing
+			{
+				Config: testAccOryCustomDomain(randomName, hostname, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "cors_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "cors_allowed_origins.0", "https://app.example.com"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOryCustomDomain(randomName string, hostname string, corsEnabled bool) string {
+	return fmt.Sprintf(`
+resource "ory_custom_domain" "%s" {
+  hostname      = "%s"
+  cookie_domain = "example.com"
+
+  cors_enabled         = %t
+  cors_allowed_origins = ["https://app.example.com"]
+}
+`, randomName, hostname, corsEnabled)
+}
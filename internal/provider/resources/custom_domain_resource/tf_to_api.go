@@ -0,0 +1,18 @@
+package custom_domain_resource
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func originsToApi(origins []types.String) []string {
+	if origins == nil {
+		return nil
+	}
+
+	apiOrigins := []string{}
+	for _, origin := range origins {
+		apiOrigins = append(apiOrigins, origin.ValueString())
+	}
+
+	return apiOrigins
+}
@@ -1,56 +1,108 @@
 package email_configuration_resource
 
 import (
+	"context"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"net/http"
-	"net/url"
+	"os"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/kibblator/terraform-provider-ory/internal/provider/helpers"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/smtpuri"
 	orytypes "github.com/kibblator/terraform-provider-ory/internal/provider/types"
 )
 
-func ApiToHttpConfig(httpConfig *orytypes.HTTP, tfConfig *emailConfigurationResourceModel) error {
+const (
+	actionBodyFetchTimeout = 10 * time.Second
+	actionBodyMaxSize      = 1 << 20
+)
+
+func ApiToHttpConfig(ctx context.Context, httpConfig *orytypes.HTTP, tfConfig *emailConfigurationResourceModel) error {
 	httpAuthType := "none"
+	var httpAuthConfig *orytypes.HttpAuthConfig
 
 	if httpConfig.HttpRequestConfig.HttpAuth != nil {
 		httpAuthType = httpConfig.HttpRequestConfig.HttpAuth.Type
+		httpAuthConfig = httpConfig.HttpRequestConfig.HttpAuth.HttpAuthConfig
 	}
 
-	username, password, in, name, value := parseHTTPAuthParams(httpAuthType, httpConfig.HttpRequestConfig.HttpAuth.HttpAuthConfig)
+	username, password, in, name, value := parseHTTPAuthParams(httpAuthType, httpAuthConfig)
+
+	previous := tfConfig.HTTPConfig
 
 	tfConfig.HTTPConfig = &HTTPConfig{
-		Url:                helpers.StringOrNil(httpConfig.HttpRequestConfig.Url),
-		RequestMethod:      helpers.StringOrNil(httpConfig.HttpRequestConfig.Method),
-		AuthenticationType: helpers.StringOrNil(httpAuthType),
+		Url:                 helpers.StringOrNil(httpConfig.HttpRequestConfig.Url),
+		RequestMethod:       helpers.StringOrNil(httpConfig.HttpRequestConfig.Method),
+		AuthenticationType:  helpers.StringOrNil(httpAuthType),
+		ActionBodyURLSHA256: types.StringNull(),
 	}
 
-	if httpConfig.HttpRequestConfig.Body != "" {
-		body, err := getBodyContents(httpConfig.HttpRequestConfig.Body)
+	if previous != nil {
+		tfConfig.HTTPConfig.ActionBodyURLFetchHash = previous.ActionBodyURLFetchHash
+	}
 
-		if err != nil {
-			return err
+	body := httpConfig.HttpRequestConfig.Body
+
+	if strings.HasPrefix(body, "base64://") {
+		encodedBody := strings.TrimPrefix(body, "base64://")
+
+		if previous != nil && isFileURL(previous.ActionBodyURL.ValueString()) && fileBodyMatches(previous.ActionBodyURL.ValueString(), encodedBody) {
+			// Local bodies are sent inline, keep the file URL while the contents match
+			tfConfig.HTTPConfig.ActionBodyURL = previous.ActionBodyURL
+		} else if previous != nil && !previous.ActionBodyJsonnet.IsNull() {
+			jsonnetBody, err := base64.StdEncoding.DecodeString(encodedBody)
+			if err != nil {
+				return fmt.Errorf("error decoding action body: %v", err)
+			}
+
+			tfConfig.HTTPConfig.ActionBodyJsonnet = helpers.StringOrNil(string(jsonnetBody))
+		} else {
+			tfConfig.HTTPConfig.ActionBody = helpers.StringOrNil(encodedBody)
 		}
+	} else if body != "" {
+		// Remote bodies are stored as-is, Ory fetches them when sending
+		tfConfig.HTTPConfig.ActionBodyURL = types.StringValue(body)
+	}
 
-		tfConfig.HTTPConfig.ActionBody = helpers.StringOrNil(body)
+	if err := ActionBodyHashToTf(ctx, tfConfig.HTTPConfig); err != nil {
+		return err
 	}
 
 	if username != "" && password != "" {
 		tfConfig.HTTPConfig.BasicAuth = &BasicAuth{
-			Username: helpers.StringOrNil(username),
-			Password: helpers.StringOrNil(password),
+			Username:          helpers.StringOrNil(username),
+			Password:          helpers.StringOrNil(password),
+			PasswordWOVersion: types.Int64Null(),
+		}
+
+		// Keep write-only passwords out of state, changes are sent when
+		// password_wo_version changes
+		if previous != nil && previous.BasicAuth != nil && previous.BasicAuth.Password.IsNull() {
+			tfConfig.HTTPConfig.BasicAuth.Password = types.StringNull()
+			tfConfig.HTTPConfig.BasicAuth.PasswordWOVersion = previous.BasicAuth.PasswordWOVersion
 		}
 	}
 
 	if in != "" && name != "" && value != "" {
 		tfConfig.HTTPConfig.ApiKey = &APIKey{
-			TransportMode: helpers.StringOrNil(in),
-			Name:          helpers.StringOrNil(name),
-			Value:         helpers.StringOrNil(value),
+			TransportMode:  helpers.StringOrNil(in),
+			Name:           helpers.StringOrNil(name),
+			Value:          helpers.StringOrNil(value),
+			ValueWOVersion: types.Int64Null(),
+		}
+
+		// Keep write-only API keys out of state, changes are sent when
+		// value_wo_version changes
+		if previous != nil && previous.ApiKey != nil && previous.ApiKey.Value.IsNull() {
+			tfConfig.HTTPConfig.ApiKey.Value = types.StringNull()
+			tfConfig.HTTPConfig.ApiKey.ValueWOVersion = previous.ApiKey.ValueWOVersion
 		}
 	}
 
@@ -79,20 +131,40 @@ func ApiToHttpConfig(httpConfig *orytypes.HTTP, tfConfig *emailConfigurationReso
 }
 
 func ApiToSmtpConfig(smtpConfig *orytypes.SMTP, tfConfig *emailConfigurationResourceModel) error {
-	argUsername, argPassword, argHost, argPort, argSecurity, argErr := parseSMTPURL(smtpConfig.ConnectionUri)
+	connectionUri, err := smtpuri.Parse(smtpConfig.ConnectionUri)
 
-	if argErr != nil {
-		return argErr
+	if err != nil {
+		return err
 	}
 
+	previous := tfConfig.SMTPConfig
+
 	tfConfig.SMTPConfig = &SMTPConfig{
-		SenderName:    helpers.StringOrNil(smtpConfig.FromName),
-		SenderAddress: helpers.StringOrNil(smtpConfig.FromAddress),
-		Host:          helpers.StringOrNil(argHost),
-		Port:          helpers.StringOrNil(argPort),
-		Security:      helpers.StringOrNil(argSecurity),
-		Username:      helpers.StringOrNil(argUsername),
-		Password:      helpers.StringOrNil(argPassword),
+		SenderName:        helpers.StringOrNil(smtpConfig.FromName),
+		SenderAddress:     helpers.StringOrNil(smtpConfig.FromAddress),
+		Host:              helpers.StringOrNil(connectionUri.Host),
+		Port:              helpers.StringOrNil(connectionUri.Port),
+		Path:              helpers.StringOrNil(connectionUri.Path),
+		Security:          helpers.StringOrNil(connectionUri.Security),
+		Username:          helpers.StringOrNil(connectionUri.Username),
+		Password:          helpers.StringOrNil(connectionUri.Password),
+		PasswordWOVersion: types.Int64Null(),
+		ServerName:        helpers.StringOrNil(connectionUri.ServerName),
+		LocalName:         helpers.StringOrNil(smtpConfig.LocalName),
+		ClientCertPath:    helpers.StringOrNil(smtpConfig.ClientCertPath),
+		ClientKeyPath:     helpers.StringOrNil(smtpConfig.ClientKeyPath),
+		QueryParams:       types.MapNull(types.ListType{ElemType: types.StringType}),
+	}
+
+	if len(connectionUri.Params) > 0 {
+		tfConfig.SMTPConfig.QueryParams = helpers.QueryParamsToTf(connectionUri.Params)
+	}
+
+	// Keep write-only passwords out of state, changes are sent when
+	// password_wo_version changes
+	if previous != nil && previous.Password.IsNull() {
+		tfConfig.SMTPConfig.Password = types.StringNull()
+		tfConfig.SMTPConfig.PasswordWOVersion = previous.PasswordWOVersion
 	}
 
 	if smtpConfig.Headers != nil {
@@ -119,86 +191,117 @@ func ApiToSmtpConfig(smtpConfig *orytypes.SMTP, tfConfig *emailConfigurationReso
 	return nil
 }
 
-func parseSMTPURL(smtpURL string) (username, password, host, port, security string, err error) {
-	parsedURL, err := url.Parse(smtpURL)
-	if err != nil {
-		return "", "", "", "", "", err
+func parseHTTPAuthParams(httpAuthType string, httpAuthConfig *orytypes.HttpAuthConfig) (username, password, in, name, value string) {
+	if httpAuthConfig == nil {
+		return "", "", "", "", ""
 	}
 
-	if parsedURL.User != nil {
-		username = parsedURL.User.Username()
-		password, _ = parsedURL.User.Password() // Password might be empty
+	if httpAuthType == "api_key" {
+		return "", "", httpAuthConfig.In, httpAuthConfig.Name, httpAuthConfig.Value
 	}
 
-	host = parsedURL.Hostname()
-	port = parsedURL.Port()
-
-	if host == "" {
-		security = ""
-	} else {
-		security = "starttls"
+	if httpAuthType == "basic_auth" {
+		return httpAuthConfig.User, httpAuthConfig.Password, "", "", ""
 	}
 
-	queryParams := parsedURL.Query()
-	param := ""
+	return "", "", "", "", ""
+}
+
+// ActionBodyHashToTf sets the SHA-256 hash of the remote action body when the
+// practitioner opted into fetching it, and clears it otherwise.
+func ActionBodyHashToTf(ctx context.Context, httpConfig *HTTPConfig) error {
+	httpConfig.ActionBodyURLSHA256 = types.StringNull()
 
-	for key, values := range queryParams {
-		param = fmt.Sprintf("%s=%s", key, values[0])
-		break
+	if !httpConfig.ActionBodyURLFetchHash.ValueBool() || httpConfig.ActionBodyURL.IsNull() {
+		return nil
 	}
 
-	switch param {
-	case "skip_ssl_verify=true":
-		if parsedURL.Scheme == "smtps" {
-			security = "implicittls_notrust"
-		} else {
-			security = "starttls_notrust"
-		}
-	case "disable_starttls=true":
-		security = "cleartext"
-	case "":
-		if parsedURL.Scheme == "smtps" {
-			security = "implicittls"
-		} else {
-			security = "starttls"
-		}
+	hash, err := fetchBodyHash(ctx, httpConfig.ActionBodyURL.ValueString())
+	if err != nil {
+		return err
 	}
 
-	return username, password, host, port, security, nil
+	httpConfig.ActionBodyURLSHA256 = types.StringValue(hash)
+
+	return nil
 }
 
-func parseHTTPAuthParams(httpAuthType string, httpAuthConfig *orytypes.HttpAuthConfig) (username, password, in, name, value string) {
-	if httpAuthType == "api_key" {
-		return "", "", httpAuthConfig.In, httpAuthConfig.Name, httpAuthConfig.Value
-	}
+func fetchBodyHash(ctx context.Context, bodyURL string) (string, error) {
+	if isFileURL(bodyURL) {
+		body, err := readActionBodyFile(bodyURL)
+		if err != nil {
+			return "", err
+		}
 
-	if httpAuthType == "basic_auth" {
-		return httpAuthConfig.User, httpAuthConfig.Password, "", "", ""
+		sum := sha256.Sum256(body)
+		return hex.EncodeToString(sum[:]), nil
 	}
 
-	return "", "", "", "", ""
-}
+	ctx, cancel := context.WithTimeout(ctx, actionBodyFetchTimeout)
+	defer cancel()
 
-func getBodyContents(input string) (string, error) {
-	parsedURL, err := url.ParseRequestURI(input)
-	if err != nil || !strings.HasPrefix(parsedURL.Scheme, "http") {
-		return input, nil
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, bodyURL, nil)
+	if err != nil {
+		return "", fmt.Errorf("error creating request for action body: %v", err)
 	}
 
-	resp, err := http.Get(input)
+	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
-		return "", fmt.Errorf("error fetching file: %v", err)
+		return "", fmt.Errorf("error fetching action body: %v", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return "", fmt.Errorf("error: received non-200 response code: %d", resp.StatusCode)
+		return "", fmt.Errorf("error: received non-200 response code fetching action body: %d", resp.StatusCode)
+	}
+
+	hash := sha256.New()
+	written, err := io.Copy(hash, io.LimitReader(resp.Body, actionBodyMaxSize+1))
+	if err != nil {
+		return "", fmt.Errorf("error reading action body: %v", err)
 	}
 
-	data, err := io.ReadAll(resp.Body)
+	if written > actionBodyMaxSize {
+		return "", fmt.Errorf("error: action body exceeds the maximum size of %d bytes", actionBodyMaxSize)
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func isFileURL(bodyURL string) bool {
+	return strings.HasPrefix(bodyURL, "file://")
+}
+
+// readActionBodyFile reads a local file:// action body, bounded in size.
+// Everything after the scheme is the path, so relative paths such as
+// file://templates/body.jsonnet are resolved against the working directory.
+func readActionBodyFile(bodyURL string) ([]byte, error) {
+	file, err := os.Open(strings.TrimPrefix(bodyURL, "file://"))
+	if err != nil {
+		return nil, fmt.Errorf("error opening action body: %v", err)
+	}
+	defer file.Close()
+
+	body, err := io.ReadAll(io.LimitReader(file, actionBodyMaxSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("error reading action body: %v", err)
+	}
+
+	if len(body) > actionBodyMaxSize {
+		return nil, fmt.Errorf("error: action body exceeds the maximum size of %d bytes", actionBodyMaxSize)
+	}
+
+	return body, nil
+}
+
+// fileBodyMatches reports whether the inline body sent to Ory still matches
+// the local file. Unreadable files don't match, so they show as drift on
+// refresh instead of hiding changes.
+func fileBodyMatches(bodyURL string, encodedBody string) bool {
+	body, err := readActionBodyFile(bodyURL)
 	if err != nil {
-		return "", fmt.Errorf("error reading response body: %v", err)
+		return false
 	}
 
-	return base64.RawStdEncoding.WithPadding(base64.StdPadding).EncodeToString(data), nil
+	return base64.StdEncoding.EncodeToString(body) == encodedBody
 }
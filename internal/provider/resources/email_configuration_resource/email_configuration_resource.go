@@ -3,8 +3,12 @@ package email_configuration_resource
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -16,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	oryclient "github.com/kibblator/terraform-provider-ory/internal/provider/clients"
 	"github.com/kibblator/terraform-provider-ory/internal/provider/custom_validators"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/smtpuri"
 
 	orytypes "github.com/kibblator/terraform-provider-ory/internal/provider/types"
 	"github.com/ory/client-go"
@@ -26,40 +31,84 @@ var (
 	_ resource.ResourceWithConfigure      = &emailConfigurationResource{}
 	_ resource.ResourceWithImportState    = &emailConfigurationResource{}
 	_ resource.ResourceWithValidateConfig = &emailConfigurationResource{}
+	_ resource.ResourceWithModifyPlan     = &emailConfigurationResource{}
 )
 
+// courierSampleContext mirrors the ctx payload Ory passes to HTTP courier
+// action bodies, used to evaluate Jsonnet bodies at plan time.
+const courierSampleContext = `{
+  "recipient": "user@example.com",
+  "template_type": "recovery_code_valid",
+  "template_data": {
+    "to": "user@example.com",
+    "recovery_code": "123456",
+    "recovery_url": "https://example.com/recovery",
+    "verification_code": "123456",
+    "verification_url": "https://example.com/verification",
+    "login_code": "123456",
+    "registration_code": "123456",
+    "identity": {
+      "id": "00000000-0000-0000-0000-000000000000",
+      "traits": {
+        "email": "user@example.com"
+      }
+    }
+  },
+  "subject": "Recover access to your account",
+  "body": "Please recover access to your account by entering the following code: 123456"
+}`
+
+// smtpPathPattern matches SMTP URI paths, the root path is equivalent to none.
+var smtpPathPattern = regexp.MustCompile(`^/.+`)
+
 type emailConfigurationResource struct {
 	oryClient *oryclient.OryClient
 }
 
 type SMTPConfig struct {
-	SenderName    types.String `tfsdk:"sender_name"`
-	SenderAddress types.String `tfsdk:"sender_address"`
-	Host          types.String `tfsdk:"host"`
-	Port          types.String `tfsdk:"port"`
-	Security      types.String `tfsdk:"security"`
-	Username      types.String `tfsdk:"username"`
-	Password      types.String `tfsdk:"password"`
+	SenderName        types.String `tfsdk:"sender_name"`
+	SenderAddress     types.String `tfsdk:"sender_address"`
+	Host              types.String `tfsdk:"host"`
+	Port              types.String `tfsdk:"port"`
+	Path              types.String `tfsdk:"path"`
+	Security          types.String `tfsdk:"security"`
+	Username          types.String `tfsdk:"username"`
+	Password          types.String `tfsdk:"password"`
+	PasswordWO        types.String `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
+	ServerName        types.String `tfsdk:"server_name"`
+	LocalName         types.String `tfsdk:"local_name"`
+	ClientCertPath    types.String `tfsdk:"client_cert_path"`
+	ClientKeyPath     types.String `tfsdk:"client_key_path"`
+	QueryParams       types.Map    `tfsdk:"query_params"`
 }
 
 type HTTPConfig struct {
-	Url                types.String `tfsdk:"url"`
-	RequestMethod      types.String `tfsdk:"request_method"`
-	AuthenticationType types.String `tfsdk:"authentication_type"`
-	BasicAuth          *BasicAuth   `tfsdk:"basic_auth"`
-	ApiKey             *APIKey      `tfsdk:"api_key"`
-	ActionBody         types.String `tfsdk:"action_body"`
+	Url                    types.String `tfsdk:"url"`
+	RequestMethod          types.String `tfsdk:"request_method"`
+	AuthenticationType     types.String `tfsdk:"authentication_type"`
+	BasicAuth              *BasicAuth   `tfsdk:"basic_auth"`
+	ApiKey                 *APIKey      `tfsdk:"api_key"`
+	ActionBody             types.String `tfsdk:"action_body"`
+	ActionBodyJsonnet      types.String `tfsdk:"action_body_jsonnet"`
+	ActionBodyURL          types.String `tfsdk:"action_body_url"`
+	ActionBodyURLFetchHash types.Bool   `tfsdk:"action_body_url_fetch_hash"`
+	ActionBodyURLSHA256    types.String `tfsdk:"action_body_url_sha256"`
 }
 
 type APIKey struct {
-	TransportMode types.String `tfsdk:"transport_mode"`
-	Name          types.String `tfsdk:"name"`
-	Value         types.String `tfsdk:"value"`
+	TransportMode  types.String `tfsdk:"transport_mode"`
+	Name           types.String `tfsdk:"name"`
+	Value          types.String `tfsdk:"value"`
+	ValueWO        types.String `tfsdk:"value_wo"`
+	ValueWOVersion types.Int64  `tfsdk:"value_wo_version"`
 }
 
 type BasicAuth struct {
-	Username types.String `tfsdk:"username"`
-	Password types.String `tfsdk:"password"`
+	Username          types.String `tfsdk:"username"`
+	Password          types.String `tfsdk:"password"`
+	PasswordWO        types.String `tfsdk:"password_wo"`
+	PasswordWOVersion types.Int64  `tfsdk:"password_wo_version"`
 }
 
 type SMTPHeader struct {
@@ -153,7 +202,7 @@ func (r *emailConfigurationResource) Schema(_ context.Context, req resource.Sche
 						Description: "The security type of the SMTP server.",
 						Required:    true,
 						Validators: []validator.String{
-							stringvalidator.OneOf("starttls", "starttls_notrust", "cleartext", "implicittls", "implicittls_notrust"),
+							stringvalidator.OneOf(smtpuri.SecurityTypes...),
 						},
 					},
 					"username": schema.StringAttribute{
@@ -161,9 +210,62 @@ func (r *emailConfigurationResource) Schema(_ context.Context, req resource.Sche
 						Required:    true,
 					},
 					"password": schema.StringAttribute{
-						Description: "The password for the SMTP server.",
-						Required:    true,
+						Description: "The password for the SMTP server. Exactly one of password or password_wo must be set.",
+						Optional:    true,
+						Sensitive:   true,
+						Validators: []validator.String{
+							stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("password_wo")),
+						},
+					},
+					"password_wo": schema.StringAttribute{
+						Description: "Write-only password for the SMTP server. Accepts ephemeral values and is never persisted to the Terraform state, so changes are only sent to Ory when password_wo_version changes. Requires Terraform 1.11 or later.",
+						Optional:    true,
 						Sensitive:   true,
+						WriteOnly:   true,
+					},
+					"password_wo_version": schema.Int64Attribute{
+						Description: "Version of password_wo. Change this value to force the write-only password to be sent to Ory again.",
+						Optional:    true,
+						Validators: []validator.Int64{
+							int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("password_wo")),
+						},
+					},
+					"path": schema.StringAttribute{
+						Description: "The path of the SMTP connection URI, for example `/relay`. Most SMTP servers don't use one.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(smtpPathPattern, "must start with `/` and not be `/` alone"),
+						},
+					},
+					"server_name": schema.StringAttribute{
+						Description: "The server name used to verify the TLS certificate of the SMTP server, sent as the server_name connection URI parameter.",
+						Optional:    true,
+					},
+					"local_name": schema.StringAttribute{
+						Description: "The hostname sent to the SMTP server with the HELO command.",
+						Optional:    true,
+					},
+					"client_cert_path": schema.StringAttribute{
+						Description: "Path to the client certificate used to authenticate with the SMTP server.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("client_key_path")),
+						},
+					},
+					"client_key_path": schema.StringAttribute{
+						Description: "Path to the private key of the client certificate used to authenticate with the SMTP server.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("client_cert_path")),
+						},
+					},
+					"query_params": schema.MapAttribute{
+						Description: "Additional query parameters of the SMTP connection URI that are not covered by other attributes, keyed by name with every value of the parameter. They are preserved as-is. skip_ssl_verify, disable_starttls and server_name are set through security and server_name instead.",
+						Optional:    true,
+						ElementType: types.ListType{ElemType: types.StringType},
+						Validators: []validator.Map{
+							mapvalidator.KeysAre(stringvalidator.NoneOf(smtpuri.DerivedParams...)),
+						},
 					},
 				},
 			},
@@ -205,9 +307,25 @@ func (r *emailConfigurationResource) Schema(_ context.Context, req resource.Sche
 								Required:    true,
 							},
 							"value": schema.StringAttribute{
-								Description: "The value of the API Key.",
-								Required:    true,
+								Description: "The value of the API Key. Exactly one of value or value_wo must be set.",
+								Optional:    true,
+								Sensitive:   true,
+								Validators: []validator.String{
+									stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("value_wo")),
+								},
+							},
+							"value_wo": schema.StringAttribute{
+								Description: "Write-only value of the API Key. Accepts ephemeral values and is never persisted to the Terraform state, so changes are only sent to Ory when value_wo_version changes. Requires Terraform 1.11 or later.",
+								Optional:    true,
 								Sensitive:   true,
+								WriteOnly:   true,
+							},
+							"value_wo_version": schema.Int64Attribute{
+								Description: "Version of value_wo. Change this value to force the write-only API Key to be sent to Ory again.",
+								Optional:    true,
+								Validators: []validator.Int64{
+									int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("value_wo")),
+								},
 							},
 						},
 					},
@@ -220,16 +338,64 @@ func (r *emailConfigurationResource) Schema(_ context.Context, req resource.Sche
 								Required:    true,
 							},
 							"password": schema.StringAttribute{
-								Description: "The password for the HTTP server auth.",
-								Required:    true,
+								Description: "The password for the HTTP server auth. Exactly one of password or password_wo must be set.",
+								Optional:    true,
 								Sensitive:   true,
+								Validators: []validator.String{
+									stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("password_wo")),
+								},
+							},
+							"password_wo": schema.StringAttribute{
+								Description: "Write-only password for the HTTP server auth. Accepts ephemeral values and is never persisted to the Terraform state, so changes are only sent to Ory when password_wo_version changes. Requires Terraform 1.11 or later.",
+								Optional:    true,
+								Sensitive:   true,
+								WriteOnly:   true,
+							},
+							"password_wo_version": schema.Int64Attribute{
+								Description: "Version of password_wo. Change this value to force the write-only password to be sent to Ory again.",
+								Optional:    true,
+								Validators: []validator.Int64{
+									int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("password_wo")),
+								},
 							},
 						},
 					},
 					"action_body": schema.StringAttribute{
 						Description: "The base64 encoded action body for the HTTP server.",
 						Optional:    true,
-						Validators:  []validator.String{custom_validators.Base64Validator{}},
+						Validators: []validator.String{
+							custom_validators.Base64Validator{},
+							stringvalidator.ConflictsWith(
+								path.MatchRelative().AtParent().AtName("action_body_jsonnet"),
+								path.MatchRelative().AtParent().AtName("action_body_url"),
+							),
+						},
+					},
+					"action_body_jsonnet": schema.StringAttribute{
+						Description: "The raw Jsonnet action body for the HTTP server. It is evaluated at plan time against a sample ctx payload to catch errors, and base64 encoded before being sent to Ory.",
+						Optional:    true,
+						Validators: []validator.String{
+							custom_validators.JsonnetValidator{SampleContext: courierSampleContext},
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("action_body_url")),
+						},
+					},
+					"action_body_url": schema.StringAttribute{
+						Description: "The http(s) or file URL of a Jsonnet action body. http(s) URLs are passed to Ory as-is and only read by the provider when action_body_url_fetch_hash is enabled. Local file URLs are read by the provider and sent to Ory inline, relative paths such as `file://templates/body.jsonnet` are resolved against the working directory.",
+						Optional:    true,
+						Validators: []validator.String{
+							custom_validators.URLValidator{Schemes: []string{"http", "https", "file"}},
+						},
+					},
+					"action_body_url_fetch_hash": schema.BoolAttribute{
+						Description: "If enabled, the provider fetches action_body_url on refresh and apply to compute action_body_url_sha256. The fetch is bounded in time and size.",
+						Optional:    true,
+						Validators: []validator.Bool{
+							boolvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("action_body_url")),
+						},
+					},
+					"action_body_url_sha256": schema.StringAttribute{
+						Description: "SHA-256 hash of the contents of action_body_url. Only set when action_body_url_fetch_hash is enabled.",
+						Computed:    true,
 					},
 				},
 			},
@@ -293,17 +459,40 @@ func (r emailConfigurationResource) ValidateConfig(ctx context.Context, req reso
 	}
 }
 
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+func (r *emailConfigurationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do when the resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan emailConfigurationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ActionBodyHashToPlan(&plan)
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
 // Create implements resource.Resource.
 func (r *emailConfigurationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan emailConfigurationResourceModel
+	var plan, config emailConfigurationResourceModel
 
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	WriteOnlyConfigToPlan(config, &plan)
+
 	var patch []client.JsonPatch
 
 	if plan.ServerType.ValueString() == "default" {
@@ -367,14 +556,14 @@ func (r *emailConfigurationResource) Create(ctx context.Context, req resource.Cr
 			Value: "http",
 		})
 
-		headersMap := make(map[string]string)
-
-		for _, header := range *plan.SMTPHeaders {
-			headersMap[header.Key.ValueString()] = header.Value.ValueString()
-		}
-
 		var httpConfig orytypes.HTTP
-		HttpConfigToApi(plan, &httpConfig)
+		if err := HttpConfigToApi(plan, &httpConfig); err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating ory email configuration",
+				"Could not read the action body: "+err.Error(),
+			)
+			return
+		}
 
 		patch = append(patch, client.JsonPatch{
 			Op:    "replace",
@@ -382,7 +571,17 @@ func (r *emailConfigurationResource) Create(ctx context.Context, req resource.Cr
 			Value: httpConfig,
 		})
 	}
-	project, _ := r.oryClient.APIClient.GetProject(&r.oryClient.Mutex)
+
+	project, err := r.oryClient.APIClient.GetProject(&r.oryClient.Mutex)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error fetching ORY project config",
+			"Could not retrieve ORY project configuration: "+err.Error(),
+		)
+		return
+	}
+
 	projectUpdate, err := r.oryClient.APIClient.PatchProject(project.RevisionId, patch, &r.oryClient.Mutex)
 
 	if err != nil {
@@ -393,6 +592,16 @@ func (r *emailConfigurationResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
+	if plan.HTTPConfig != nil {
+		if err := ActionBodyHashToTf(ctx, plan.HTTPConfig); err != nil {
+			resp.Diagnostics.AddError(
+				"Error fetching ory email action body",
+				"Could not fetch the action body to compute its hash: "+err.Error(),
+			)
+			return
+		}
+	}
+
 	plan.ID = types.StringValue("email_configuration_settings")
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
 
@@ -457,7 +666,7 @@ func (r *emailConfigurationResource) Read(ctx context.Context, req resource.Read
 	}
 
 	if serverType == "http" {
-		err := ApiToHttpConfig(project.Services.Identity.Config.Courier.HTTP, &state)
+		err := ApiToHttpConfig(ctx, project.Services.Identity.Config.Courier.HTTP, &state)
 
 		if err != nil {
 			resp.Diagnostics.AddError(
@@ -482,13 +691,16 @@ func (r *emailConfigurationResource) Read(ctx context.Context, req resource.Read
 
 // Update implements resource.Resource.
 func (r *emailConfigurationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan emailConfigurationResourceModel
+	var plan, config emailConfigurationResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	WriteOnlyConfigToPlan(config, &plan)
+
 	var patch []client.JsonPatch
 
 	if plan.ServerType.ValueString() == "default" {
@@ -553,7 +765,13 @@ func (r *emailConfigurationResource) Update(ctx context.Context, req resource.Up
 		})
 
 		var httpConfig orytypes.HTTP
-		HttpConfigToApi(plan, &httpConfig)
+		if err := HttpConfigToApi(plan, &httpConfig); err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating ory email configuration",
+				"Could not read the action body: "+err.Error(),
+			)
+			return
+		}
 
 		patch = append(patch, client.JsonPatch{
 			Op:    "replace",
@@ -562,8 +780,17 @@ func (r *emailConfigurationResource) Update(ctx context.Context, req resource.Up
 		})
 	}
 
-	project, _ := r.oryClient.APIClient.GetProject(&r.oryClient.Mutex)
-	_, err := r.oryClient.APIClient.PatchProject(project.RevisionId, patch, &r.oryClient.Mutex)
+	project, err := r.oryClient.APIClient.GetProject(&r.oryClient.Mutex)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error fetching ORY project config",
+			"Could not retrieve ORY project configuration: "+err.Error(),
+		)
+		return
+	}
+
+	_, err = r.oryClient.APIClient.PatchProject(project.RevisionId, patch, &r.oryClient.Mutex)
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -573,6 +800,16 @@ func (r *emailConfigurationResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 
+	if plan.HTTPConfig != nil {
+		if err := ActionBodyHashToTf(ctx, plan.HTTPConfig); err != nil {
+			resp.Diagnostics.AddError(
+				"Error fetching ory email action body",
+				"Could not fetch the action body to compute its hash: "+err.Error(),
+			)
+			return
+		}
+	}
+
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
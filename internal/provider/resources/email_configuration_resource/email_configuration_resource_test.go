@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/kibblator/terraform-provider-ory/internal/provider/acctest"
 )
 
@@ -26,6 +28,7 @@ func TestAccOryEmailConfiguration(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "smtp_config.port", "587"),
 					resource.TestCheckResourceAttr(resourceName, "smtp_config.security", "starttls"),
 					resource.TestCheckResourceAttr(resourceName, "smtp_config.username", "username"),
+					resource.TestCheckResourceAttr(resourceName, "smtp_config.server_name", "mail.examplecompany.com"),
 				),
 			},
 			{
@@ -51,6 +54,25 @@ func TestAccOryEmailConfiguration(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "http_config.authentication_type", "basic_auth"),
 					resource.TestCheckResourceAttr(resourceName, "http_config.basic_auth.username", "username"),
 					resource.TestCheckResourceAttr(resourceName, "http_config.basic_auth.password", "password"),
+					resource.TestCheckResourceAttr(resourceName, "http_config.action_body", "aGVsbG8gd29ybGQ="),
+				),
+			},
+			{
+				Config: testAccOryEmailConfiguration_HTTPBodyURL(randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "server_type", "http"),
+					resource.TestCheckNoResourceAttr(resourceName, "http_config.action_body"),
+					resource.TestCheckResourceAttr(resourceName, "http_config.action_body_url", "https://raw.githubusercontent.com/ory/kratos/master/courier/template/courier/builtin/templates/http_body.jsonnet"),
+					resource.TestCheckNoResourceAttr(resourceName, "http_config.action_body_url_sha256"),
+				),
+			},
+			{
+				Config: testAccOryEmailConfiguration_HTTPBodyJsonnet(randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "server_type", "http"),
+					resource.TestCheckNoResourceAttr(resourceName, "http_config.action_body"),
+					resource.TestCheckNoResourceAttr(resourceName, "http_config.action_body_url"),
+					resource.TestCheckResourceAttr(resourceName, "http_config.action_body_jsonnet", "function(ctx) { recipient: ctx.recipient, template_type: ctx.template_type }\n"),
 				),
 			},
 		},
@@ -70,6 +92,7 @@ resource "ory_email_configuration" "%s" {
     security       = "starttls"
     username       = "username"
     password       = "password"
+    server_name    = "mail.examplecompany.com"
   }
 
   smtp_headers = [
@@ -122,12 +145,178 @@ resource "ory_email_configuration" "%s" {
       value = "value-2"
     }
   ]
+}
+`, randomName)
+}
+
+func testAccOryEmailConfiguration_HTTPBodyJsonnet(randomName string) string {
+	return fmt.Sprintf(`
+resource "ory_email_configuration" "%s" {
+  server_type = "http"
 
-  lifecycle {
-    ignore_changes = [
-      http_config.action_body
-    ]
+  http_config = {
+    url                 = "https://ory.sh"
+    request_method      = "POST"
+    authentication_type = "none"
+    action_body_jsonnet = <<-EOT
+      function(ctx) { recipient: ctx.recipient, template_type: ctx.template_type }
+    EOT
   }
+
+  smtp_headers = [
+    {
+      key   = "X-Header-1"
+      value = "value-1"
+    }
+  ]
 }
 `, randomName)
 }
+
+func testAccOryEmailConfiguration_HTTPBodyURL(randomName string) string {
+	return fmt.Sprintf(`
+resource "ory_email_configuration" "%s" {
+  server_type = "http"
+
+  http_config = {
+    url                 = "https://ory.sh"
+    request_method      = "POST"
+    authentication_type = "none"
+    action_body_url     = "https://raw.githubusercontent.com/ory/kratos/master/courier/template/courier/builtin/templates/http_body.jsonnet"
+  }
+
+  smtp_headers = [
+    {
+      key   = "X-Header-1"
+      value = "value-1"
+    }
+  ]
+}
+`, randomName)
+}
+
+func TestAccOryEmailConfiguration_WriteOnly(t *testing.T) {
+	randomName := acctest.GenerateRandomResourceName()
+	resourceName := fmt.Sprintf("ory_email_configuration.%s", randomName)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.11.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOryEmailConfiguration_SMTPWriteOnly(randomName, "password", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "server_type", "smtp"),
+					resource.TestCheckNoResourceAttr(resourceName, "smtp_config.password"),
+					resource.TestCheckNoResourceAttr(resourceName, "smtp_config.password_wo"),
+					resource.TestCheckResourceAttr(resourceName, "smtp_config.password_wo_version", "1"),
+				),
+			},
+			{
+				Config: testAccOryEmailConfiguration_SMTPWriteOnly(randomName, "rotated", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "smtp_config.password_wo_version", "2"),
+				),
+			},
+			{
+				Config: testAccOryEmailConfiguration_HTTPBasicAuthWriteOnly(randomName, "password", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "server_type", "http"),
+					resource.TestCheckResourceAttr(resourceName, "http_config.basic_auth.username", "username"),
+					resource.TestCheckNoResourceAttr(resourceName, "http_config.basic_auth.password"),
+					resource.TestCheckNoResourceAttr(resourceName, "http_config.basic_auth.password_wo"),
+					resource.TestCheckResourceAttr(resourceName, "http_config.basic_auth.password_wo_version", "1"),
+				),
+			},
+			{
+				Config: testAccOryEmailConfiguration_HTTPBasicAuthWriteOnly(randomName, "rotated", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "http_config.basic_auth.password_wo_version", "2"),
+				),
+			},
+			{
+				Config: testAccOryEmailConfiguration_HTTPAPIKeyWriteOnly(randomName, "key", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "http_config.authentication_type", "api_key"),
+					resource.TestCheckResourceAttr(resourceName, "http_config.api_key.name", "X-API-Key"),
+					resource.TestCheckNoResourceAttr(resourceName, "http_config.api_key.value"),
+					resource.TestCheckNoResourceAttr(resourceName, "http_config.api_key.value_wo"),
+					resource.TestCheckResourceAttr(resourceName, "http_config.api_key.value_wo_version", "1"),
+				),
+			},
+			{
+				Config: testAccOryEmailConfiguration_HTTPAPIKeyWriteOnly(randomName, "rotated", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "http_config.api_key.value_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOryEmailConfiguration_SMTPWriteOnly(randomName string, password string, passwordVersion int) string {
+	return fmt.Sprintf(`
+resource "ory_email_configuration" "%s" {
+  server_type = "smtp"
+
+  smtp_config = {
+    sender_name         = "Ory"
+    sender_address      = "noreply@examplecompany.com"
+    host                = "smtp.examplecompany.com"
+    port                = "587"
+    security            = "starttls"
+    username            = "username"
+    password_wo         = "%s"
+    password_wo_version = %d
+  }
+}
+`, randomName, password, passwordVersion)
+}
+
+func testAccOryEmailConfiguration_HTTPBasicAuthWriteOnly(randomName string, password string, passwordVersion int) string {
+	return fmt.Sprintf(`
+resource "ory_email_configuration" "%s" {
+  server_type = "http"
+
+  http_config = {
+    url                 = "https://ory.sh"
+    request_method      = "POST"
+    authentication_type = "basic_auth"
+
+    basic_auth = {
+      username            = "username"
+      password_wo         = "%s"
+      password_wo_version = %d
+    }
+
+    action_body = "aGVsbG8gd29ybGQ="
+  }
+}
+`, randomName, password, passwordVersion)
+}
+
+func testAccOryEmailConfiguration_HTTPAPIKeyWriteOnly(randomName string, value string, valueVersion int) string {
+	return fmt.Sprintf(`
+resource "ory_email_configuration" "%s" {
+  server_type = "http"
+
+  http_config = {
+    url                 = "https://ory.sh"
+    request_method      = "POST"
+    authentication_type = "api_key"
+
+    api_key = {
+      transport_mode   = "header"
+      name             = "X-API-Key"
+      value_wo         = "%s"
+      value_wo_version = %d
+    }
+
+    action_body = "aGVsbG8gd29ybGQ="
+  }
+}
+`, randomName, value, valueVersion)
+}
@@ -1,13 +1,18 @@
 package email_configuration_resource
 
 import (
-	"fmt"
+	"encoding/base64"
 	"net/url"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/helpers"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/smtpuri"
 	orytypes "github.com/kibblator/terraform-provider-ory/internal/provider/types"
 )
 
-func HttpConfigToApi(tfConfig emailConfigurationResourceModel, httpConfig *orytypes.HTTP) {
+// HttpConfigToApi maps the HTTP delivery settings onto the API model. Local
+// file:// action bodies are read and sent inline, as Ory can't access them.
+func HttpConfigToApi(tfConfig emailConfigurationResourceModel, httpConfig *orytypes.HTTP) error {
 	headersMap := make(map[string]string)
 
 	if tfConfig.SMTPHeaders != nil {
@@ -30,21 +35,41 @@ func HttpConfigToApi(tfConfig emailConfigurationResourceModel, httpConfig *oryty
 		if authenticationType == "api_key" {
 			httpConfig.HttpRequestConfig.HttpAuth.HttpAuthConfig.In = tfConfig.HTTPConfig.ApiKey.TransportMode.ValueString()
 			httpConfig.HttpRequestConfig.HttpAuth.HttpAuthConfig.Name = tfConfig.HTTPConfig.ApiKey.Name.ValueString()
-			httpConfig.HttpRequestConfig.HttpAuth.HttpAuthConfig.Value = tfConfig.HTTPConfig.ApiKey.Value.ValueString()
+			httpConfig.HttpRequestConfig.HttpAuth.HttpAuthConfig.Value = secretValue(tfConfig.HTTPConfig.ApiKey.Value, tfConfig.HTTPConfig.ApiKey.ValueWO)
 		}
 
 		if authenticationType == "basic_auth" {
 			httpConfig.HttpRequestConfig.HttpAuth.HttpAuthConfig.User = tfConfig.HTTPConfig.BasicAuth.Username.ValueString()
-			httpConfig.HttpRequestConfig.HttpAuth.HttpAuthConfig.Password = tfConfig.HTTPConfig.BasicAuth.Password.ValueString()
+			httpConfig.HttpRequestConfig.HttpAuth.HttpAuthConfig.Password = secretValue(tfConfig.HTTPConfig.BasicAuth.Password, tfConfig.HTTPConfig.BasicAuth.PasswordWO)
 		}
 	}
 
 	if tfConfig.HTTPConfig.ActionBody.ValueString() != "" {
 		httpConfig.HttpRequestConfig.Body = "base64://" + tfConfig.HTTPConfig.ActionBody.ValueString()
 	}
+
+	if tfConfig.HTTPConfig.ActionBodyJsonnet.ValueString() != "" {
+		httpConfig.HttpRequestConfig.Body = "base64://" + helpers.EncodeJsonnetBody(tfConfig.HTTPConfig.ActionBodyJsonnet.ValueString())
+	}
+
+	if tfConfig.HTTPConfig.ActionBodyURL.ValueString() != "" {
+		httpConfig.HttpRequestConfig.Body = tfConfig.HTTPConfig.ActionBodyURL.ValueString()
+	}
+
+	if isFileURL(tfConfig.HTTPConfig.ActionBodyURL.ValueString()) {
+		body, err := readActionBodyFile(tfConfig.HTTPConfig.ActionBodyURL.ValueString())
+		if err != nil {
+			return err
+		}
+
+		httpConfig.HttpRequestConfig.Body = "base64://" + base64.StdEncoding.EncodeToString(body)
+	}
+
 	httpConfig.HttpRequestConfig.Method = tfConfig.HTTPConfig.RequestMethod.ValueString()
 	httpConfig.HttpRequestConfig.Url = tfConfig.HTTPConfig.Url.ValueString()
 	httpConfig.HttpRequestConfig.Headers = headersMap
+
+	return nil
 }
 
 func SmtpConfigToApi(tfConfig emailConfigurationResourceModel, smtpConfig *orytypes.SMTP) {
@@ -56,47 +81,75 @@ func SmtpConfigToApi(tfConfig emailConfigurationResourceModel, smtpConfig *oryty
 		}
 	}
 
-	smtpConfig.ConnectionUri = buildSMTPURL(tfConfig.SMTPConfig.Username.ValueString(), tfConfig.SMTPConfig.Password.ValueString(),
-		tfConfig.SMTPConfig.Host.ValueString(), tfConfig.SMTPConfig.Port.ValueString(), tfConfig.SMTPConfig.Security.ValueString())
+	connectionUri := smtpuri.URI{
+		Username:   tfConfig.SMTPConfig.Username.ValueString(),
+		Password:   secretValue(tfConfig.SMTPConfig.Password, tfConfig.SMTPConfig.PasswordWO),
+		Host:       tfConfig.SMTPConfig.Host.ValueString(),
+		Port:       tfConfig.SMTPConfig.Port.ValueString(),
+		Path:       tfConfig.SMTPConfig.Path.ValueString(),
+		Security:   tfConfig.SMTPConfig.Security.ValueString(),
+		ServerName: tfConfig.SMTPConfig.ServerName.ValueString(),
+		Params:     url.Values{},
+	}
+
+	for key, value := range tfConfig.SMTPConfig.QueryParams.Elements() {
+		listValue, ok := value.(types.List)
+		if !ok {
+			continue
+		}
+
+		for _, element := range listValue.Elements() {
+			if stringValue, ok := element.(types.String); ok {
+				connectionUri.Params.Add(key, stringValue.ValueString())
+			}
+		}
+	}
+
+	smtpConfig.ConnectionUri = connectionUri.String()
 	smtpConfig.FromAddress = tfConfig.SMTPConfig.SenderAddress.ValueString()
 	smtpConfig.FromName = tfConfig.SMTPConfig.SenderName.ValueString()
 	smtpConfig.Headers = headersMap
+	smtpConfig.LocalName = tfConfig.SMTPConfig.LocalName.ValueString()
+	smtpConfig.ClientCertPath = tfConfig.SMTPConfig.ClientCertPath.ValueString()
+	smtpConfig.ClientKeyPath = tfConfig.SMTPConfig.ClientKeyPath.ValueString()
 }
 
-func buildSMTPURL(username, password, host string, port string, securityType string) string {
-	if securityType == "" {
-		securityType = "starttls"
+// WriteOnlyConfigToPlan copies write-only secrets from the configuration into
+// the plan, as the framework always nulls them out in the plan itself.
+func WriteOnlyConfigToPlan(config emailConfigurationResourceModel, plan *emailConfigurationResourceModel) {
+	if config.SMTPConfig != nil && plan.SMTPConfig != nil {
+		plan.SMTPConfig.PasswordWO = config.SMTPConfig.PasswordWO
+	}
+
+	if config.HTTPConfig == nil || plan.HTTPConfig == nil {
+		return
 	}
 
-	escapedUsername := url.QueryEscape(username)
-	escapedPassword := url.QueryEscape(password)
+	if config.HTTPConfig.BasicAuth != nil && plan.HTTPConfig.BasicAuth != nil {
+		plan.HTTPConfig.BasicAuth.PasswordWO = config.HTTPConfig.BasicAuth.PasswordWO
+	}
 
-	scheme := "smtp"
-	query := ""
+	if config.HTTPConfig.ApiKey != nil && plan.HTTPConfig.ApiKey != nil {
+		plan.HTTPConfig.ApiKey.ValueWO = config.HTTPConfig.ApiKey.ValueWO
+	}
+}
 
-	switch securityType {
-	case "starttls":
-		scheme = "smtp"
-		query = ""
-	case "starttls_notrust":
-		scheme = "smtp"
-		query = "skip_ssl_verify=true"
-	case "cleartext":
-		scheme = "smtp"
-		query = "disable_starttls=true"
-	case "implicittls":
-		scheme = "smtps"
-		query = ""
-	case "implicittls_notrust":
-		scheme = "smtps"
-		query = "skip_ssl_verify=true"
+// ActionBodyHashToPlan clears the planned action body hash unless it will be
+// computed by fetching the action body URL during apply.
+func ActionBodyHashToPlan(plan *emailConfigurationResourceModel) {
+	if plan.HTTPConfig == nil {
+		return
 	}
 
-	smtpURI := fmt.Sprintf("%s://%s:%s@%s:%s", scheme, escapedUsername, escapedPassword, host, port)
+	if plan.HTTPConfig.ActionBodyURL.IsNull() || (!plan.HTTPConfig.ActionBodyURLFetchHash.IsUnknown() && !plan.HTTPConfig.ActionBodyURLFetchHash.ValueBool()) {
+		plan.HTTPConfig.ActionBodyURLSHA256 = types.StringNull()
+	}
+}
 
-	if query != "" {
-		smtpURI = fmt.Sprintf("%s?%s", smtpURI, query)
+func secretValue(value types.String, writeOnlyValue types.String) string {
+	if !writeOnlyValue.IsNull() {
+		return writeOnlyValue.ValueString()
 	}
 
-	return smtpURI
+	return value.ValueString()
 }
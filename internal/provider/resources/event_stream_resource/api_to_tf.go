@@ -0,0 +1,14 @@
+package event_stream_resource
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ory/client-go"
+)
+
+// ApiToEventStream maps the event stream returned by the API onto the model.
+func ApiToEventStream(stream *client.EventStream, tfConfig *eventStreamResourceModel) {
+	tfConfig.ID = types.StringValue(stream.GetId())
+	tfConfig.Type = types.StringValue(stream.GetType())
+	tfConfig.TopicARN = types.StringValue(stream.GetTopicArn())
+	tfConfig.RoleARN = types.StringValue(stream.GetRoleArn())
+}
@@ -0,0 +1,256 @@
+package event_stream_resource
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	oryclient "github.com/kibblator/terraform-provider-ory/internal/provider/clients"
+	"github.com/ory/client-go"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &eventStreamResource{}
+	_ resource.ResourceWithConfigure   = &eventStreamResource{}
+	_ resource.ResourceWithImportState = &eventStreamResource{}
+)
+
+// NewEventStreamResource is a helper function to simplify the provider implementation.
+func NewEventStreamResource() resource.Resource {
+	return &eventStreamResource{}
+}
+
+// eventStreamResource is the resource implementation.
+type eventStreamResource struct {
+	oryClient *oryclient.OryClient
+}
+
+// eventStreamResourceModel maps the resource schema data.
+type eventStreamResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	LastUpdated types.String `tfsdk:"last_updated"`
+	Type        types.String `tfsdk:"type"`
+	TopicARN    types.String `tfsdk:"topic_arn"`
+	RoleARN     types.String `tfsdk:"role_arn"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *eventStreamResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*oryclient.OryClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected OryClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.oryClient = client
+}
+
+// Metadata returns the resource type name.
+func (r *eventStreamResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_event_stream"
+}
+
+// Schema defines the schema for the resource.
+func (r *eventStreamResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an event stream of the project, publishing events such as created identities and issued sessions to an AWS SNS topic. SNS is currently the only sink supported by Ory Network.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the event stream.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update of the event stream.",
+				Computed:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "Type of the sink. Only `sns` is supported. Defaults to `sns`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(sinkTypeSNS),
+				Validators: []validator.String{
+					stringvalidator.OneOf(sinkTypeSNS),
+				},
+			},
+			"topic_arn": schema.StringAttribute{
+				Description: "ARN of the SNS topic events are published to.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(topicARNPattern, "must be the ARN of an SNS topic"),
+				},
+			},
+			"role_arn": schema.StringAttribute{
+				Description: "ARN of the IAM role Ory assumes to publish to the topic. The role has to trust the Ory Network AWS account.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(roleARNPattern, "must be the ARN of an IAM role"),
+				},
+			},
+		},
+	}
+}
+
+// Create a new resource.
+func (r *eventStreamResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan eventStreamResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stream, _, err := r.oryClient.ConsoleAPIClient.EventsAPI.CreateEventStream(ctx, r.oryClient.ProjectID).CreateEventStreamBody(EventStreamToCreateApi(plan)).Execute()
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating ory event stream",
+			"Could not create ory event stream, unexpected error: "+oryclient.ErrorDetail(err),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	ApiToEventStream(stream, &plan)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *eventStreamResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading event stream resource")
+
+	// Retrieve current state
+	var state eventStreamResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// There is no endpoint returning a single event stream
+	streams, _, err := r.oryClient.ConsoleAPIClient.EventsAPI.ListEventStreams(ctx, r.oryClient.ProjectID).Execute()
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error fetching ORY event stream",
+			"Could not retrieve ORY event stream: "+oryclient.ErrorDetail(err),
+		)
+		return
+	}
+
+	stream := findEventStream(streams.EventStreams, state.ID.ValueString())
+
+	if stream == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	ApiToEventStream(stream, &state)
+
+	// Set the updated state
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *eventStreamResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve the desired state from the plan
+	var plan eventStreamResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stream, _, err := r.oryClient.ConsoleAPIClient.EventsAPI.SetEventStream(ctx, r.oryClient.ProjectID, plan.ID.ValueString()).SetEventStreamBody(EventStreamToSetApi(plan)).Execute()
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating ory event stream",
+			"Could not update ory event stream, unexpected error: "+oryclient.ErrorDetail(err),
+		)
+		return
+	}
+
+	ApiToEventStream(stream, &plan)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set the updated plan to the state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *eventStreamResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state eventStreamResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.oryClient.ConsoleAPIClient.EventsAPI.DeleteEventStream(ctx, r.oryClient.ProjectID, state.ID.ValueString()).Execute()
+
+	if err != nil && (httpResp == nil || httpResp.StatusCode != http.StatusNotFound) {
+		resp.Diagnostics.AddError(
+			"Error deleting ory event stream",
+			"Could not delete ory event stream, unexpected error: "+oryclient.ErrorDetail(err),
+		)
+		return
+	}
+}
+
+func (r *eventStreamResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// findEventStream returns the event stream with the given ID, or nil if the
+// project has no such stream.
+func findEventStream(streams []client.EventStream, id string) *client.EventStream {
+	for i := range streams {
+		if streams[i].GetId() == id {
+			return &streams[i]
+		}
+	}
+
+	return nil
+}
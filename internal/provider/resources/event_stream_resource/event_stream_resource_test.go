@@ -0,0 +1,55 @@
+package event_stream_resource_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/kibblator/terraform-provider-ory/internal/provider/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOryEventStreamResource(t *testing.T) {
+	randomName := acctest.GenerateRandomResourceName()
+	resourceName := fmt.Sprintf("ory_event_stream.%s", randomName)
+	topicARN := os.Getenv("ORY_TEST_SNS_TOPIC_ARN")
+	roleARN := os.Getenv("ORY_TEST_SNS_ROLE_ARN")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		PreCheck: func() {
+			acctest.TestAccPreCheck(t)
+
+			if topicARN == "" || roleARN == "" {
+				t.Skip("ORY_TEST_SNS_TOPIC_ARN and ORY_TEST_SNS_ROLE_ARN must be set for event stream acceptance tests")
+			}
+		},
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: fmt.Sprintf(`
+resource "ory_event_stream" "%s" {
+  topic_arn = "%s"
+  role_arn  = "%s"
+}
+`, randomName, topicARN, roleARN),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "type", "sns"),
+					resource.TestCheckResourceAttr(resourceName, "topic_arn", topicARN),
+					resource.TestCheckResourceAttrSet(resourceName, "last_updated"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"last_updated", // If 'last_updated' isn't returned from the ORY API, ignore it during import verification
+				},
+			},
+		},
+	})
+}
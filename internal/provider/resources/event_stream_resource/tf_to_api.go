@@ -0,0 +1,32 @@
+package event_stream_resource
+
+import (
+	"regexp"
+
+	"github.com/ory/client-go"
+)
+
+const sinkTypeSNS = "sns"
+
+var (
+	topicARNPattern = regexp.MustCompile(`^arn:aws[a-z-]*:sns:[a-z0-9-]+:\d{12}:[A-Za-z0-9_-]+(\.fifo)?$`)
+	roleARNPattern  = regexp.MustCompile(`^arn:aws[a-z-]*:iam::\d{12}:role/[\w+=,.@/-]+$`)
+)
+
+// EventStreamToCreateApi maps the model onto the event stream creation body.
+func EventStreamToCreateApi(tfConfig eventStreamResourceModel) client.CreateEventStreamBody {
+	return client.CreateEventStreamBody{
+		Type:     tfConfig.Type.ValueString(),
+		TopicArn: tfConfig.TopicARN.ValueString(),
+		RoleArn:  tfConfig.RoleARN.ValueString(),
+	}
+}
+
+// EventStreamToSetApi maps the model onto the event stream update body.
+func EventStreamToSetApi(tfConfig eventStreamResourceModel) client.SetEventStreamBody {
+	return client.SetEventStreamBody{
+		Type:     tfConfig.Type.ValueString(),
+		TopicArn: tfConfig.TopicARN.ValueString(),
+		RoleArn:  tfConfig.RoleARN.ValueString(),
+	}
+}
@@ -0,0 +1,298 @@
+package identity_import_resource
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	oryclient "github.com/kibblator/terraform-provider-ory/internal/provider/clients"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/helpers"
+)
+
+const (
+	defaultFormat          = "jsonl"
+	defaultSchemaID        = "preset://email"
+	defaultIdentifierTrait = "email"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &identityImportResource{}
+	_ resource.ResourceWithConfigure      = &identityImportResource{}
+	_ resource.ResourceWithValidateConfig = &identityImportResource{}
+)
+
+// NewIdentityImportResource is a helper function to simplify the provider implementation.
+func NewIdentityImportResource() resource.Resource {
+	return &identityImportResource{}
+}
+
+// identityImportResource is the resource implementation.
+type identityImportResource struct {
+	oryClient *oryclient.OryClient
+}
+
+// identityImportResourceModel maps the resource schema data.
+type identityImportResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	LastUpdated     types.String `tfsdk:"last_updated"`
+	Source          types.String `tfsdk:"source"`
+	Format          types.String `tfsdk:"format"`
+	SchemaID        types.String `tfsdk:"schema_id"`
+	IdentifierTrait types.String `tfsdk:"identifier_trait"`
+	BatchSize       types.Int64  `tfsdk:"batch_size"`
+	Concurrency     types.Int64  `tfsdk:"concurrency"`
+	ImportedCount   types.Int64  `tfsdk:"imported_count"`
+	SkippedCount    types.Int64  `tfsdk:"skipped_count"`
+	IdentityIDs     types.Map    `tfsdk:"identity_ids"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *identityImportResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*oryclient.OryClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected OryClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.oryClient = client
+}
+
+// Metadata returns the resource type name.
+func (r *identityImportResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_identity_import"
+}
+
+// Schema defines the schema for the resource.
+func (r *identityImportResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Imports identities in bulk, for example when migrating from another identity provider. Identities whose credential identifier already exists are skipped, so the import can be applied again. Rows that fail are reported and retried on the next apply. Destroying the resource keeps the imported identities.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "String identifier of the identity import resource.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update of the identity import.",
+				Computed:    true,
+			},
+			"source": schema.StringAttribute{
+				Description: "The identities to import, usually read with `file()`. In `jsonl` format every line is an identity in the format of the identity API, with `password_hash` as a shorthand for the hashed password. In `csv` format the columns `schema_id`, `state`, `password_hash`, `metadata_public` and `metadata_admin` are read as such, all other columns are traits, with dots denoting nested traits. Password hashes must be in a format supported by Ory, such as bcrypt, argon2, pbkdf2, scrypt or md5.",
+				Required:    true,
+				Sensitive:   true,
+			},
+			"format": schema.StringAttribute{
+				Description: "Format of the source, `jsonl` or `csv`. Defaults to `jsonl`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(defaultFormat),
+				Validators: []validator.String{
+					stringvalidator.OneOf("jsonl", "csv"),
+				},
+			},
+			"schema_id": schema.StringAttribute{
+				Description: "Identity schema of the rows not setting one. Defaults to `preset://email`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(defaultSchemaID),
+			},
+			"identifier_trait": schema.StringAttribute{
+				Description: "Trait holding the credential identifier used to match existing identities. Defaults to `email`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(defaultIdentifierTrait),
+			},
+			"batch_size": schema.Int64Attribute{
+				Description: "Number of identities created per API call, at most 2000. Defaults to 500.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(500),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 2000),
+				},
+			},
+			"concurrency": schema.Int64Attribute{
+				Description: "Number of API calls made at the same time. Defaults to 4.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(4),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 16),
+				},
+			},
+			"imported_count": schema.Int64Attribute{
+				Description: "Number of identities created by the last apply.",
+				Computed:    true,
+			},
+			"skipped_count": schema.Int64Attribute{
+				Description: "Number of identities skipped by the last apply as they already existed.",
+				Computed:    true,
+			},
+			"identity_ids": schema.MapAttribute{
+				Description: "IDs of the imported identities, keyed by their identifier.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig reports invalid rows of the source at plan time.
+func (r *identityImportResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config identityImportResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Source.IsUnknown() || config.Format.IsUnknown() || config.IdentifierTrait.IsUnknown() {
+		return
+	}
+
+	_, errs := parseSource(config)
+
+	for _, err := range errs {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("source"),
+			"Invalid identity import row",
+			err.Error(),
+		)
+	}
+}
+
+// Create a new resource.
+func (r *identityImportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan identityImportResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Leave the state unset when rows failed, applying again retries them and
+	// skips the identities that were imported
+	if !r.importIdentities(ctx, &plan, resp.Diagnostics.AddError) {
+		return
+	}
+
+	plan.ID = types.StringValue("identity_import:" + helpers.ContentID(plan.Source.ValueString()))
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read keeps the state as is, it only records the outcome of the last import.
+func (r *identityImportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+}
+
+func (r *identityImportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve the desired state from the plan
+	var plan identityImportResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state identityImportResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only record the rows that succeeded, keeping the previous source so the
+	// next apply retries the failed rows
+	if !r.importIdentities(ctx, &plan, resp.Diagnostics.AddError) {
+		plan.Source = state.Source
+	}
+
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set the updated plan to the state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete removes the Terraform state, imported identities are kept.
+func (r *identityImportResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+// importIdentities imports the rows of the planned source and records the
+// outcome in the plan, reporting every failed row. It returns whether all
+// rows were imported or skipped.
+func (r *identityImportResource) importIdentities(ctx context.Context, plan *identityImportResourceModel, addError func(summary, detail string)) bool {
+	rows, errs := parseSource(*plan)
+
+	for _, err := range errs {
+		addError("Invalid identity import row", err.Error())
+	}
+
+	result := oryclient.ImportIdentities(ctx, r.oryClient.ProjectAPIClient, rows, int(plan.BatchSize.ValueInt64()), int(plan.Concurrency.ValueInt64()))
+
+	for _, failure := range result.Failures {
+		addError("Error importing ory identity", failure.Error())
+	}
+
+	identityIDs, _ := types.MapValueFrom(ctx, types.StringType, result.IdentityIDs)
+
+	plan.ImportedCount = types.Int64Value(int64(result.Imported))
+	plan.SkippedCount = types.Int64Value(int64(result.Skipped))
+	plan.IdentityIDs = identityIDs
+
+	return len(errs) == 0 && len(result.Failures) == 0
+}
+
+func parseSource(config identityImportResourceModel) ([]helpers.IdentityImportRow, []error) {
+	format := config.Format.ValueString()
+	if config.Format.IsNull() {
+		format = defaultFormat
+	}
+
+	schemaID := config.SchemaID.ValueString()
+	if config.SchemaID.IsNull() {
+		schemaID = defaultSchemaID
+	}
+
+	identifierTrait := config.IdentifierTrait.ValueString()
+	if config.IdentifierTrait.IsNull() {
+		identifierTrait = defaultIdentifierTrait
+	}
+
+	return helpers.ParseIdentityImport(config.Source.ValueString(), format, schemaID, identifierTrait)
+}
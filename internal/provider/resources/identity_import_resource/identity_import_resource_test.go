@@ -0,0 +1,75 @@
+package identity_import_resource_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/kibblator/terraform-provider-ory/internal/provider/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOryIdentityImportResource(t *testing.T) {
+	randomName := acctest.GenerateRandomResourceName()
+	resourceName := fmt.Sprintf("ory_identity_import.%s", randomName)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			// Invalid rows are rejected at plan time
+			{
+				Config: fmt.Sprintf(`
+resource "ory_identity_import" "%s" {
+  format = "csv"
+  source = <<-EOT
+    email,password_hash
+    %s-1@example.com,plain
+  EOT
+}
+`, randomName, randomName),
+				ExpectError: regexp.MustCompile("unsupported password hash format"),
+			},
+			// Create and Read testing
+			{
+				Config: fmt.Sprintf(`
+resource "ory_identity_import" "%s" {
+  format     = "csv"
+  batch_size = 1
+  source     = <<-EOT
+    email,password_hash
+    %s-1@example.com,$2a$10$ZsCsoVQ3xfBG/K2z2XpBf.tm90GZmtOqtqWcB5.pYd5Eq8y7RlDyq
+    %s-2@example.com,$2a$10$ZsCsoVQ3xfBG/K2z2XpBf.tm90GZmtOqtqWcB5.pYd5Eq8y7RlDyq
+  EOT
+}
+`, randomName, randomName, randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "imported_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "skipped_count", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, fmt.Sprintf("identity_ids.%s-1@example.com", randomName)),
+					resource.TestCheckResourceAttrSet(resourceName, "last_updated"),
+				),
+			},
+			// Existing identities are skipped when the source changes
+			{
+				Config: fmt.Sprintf(`
+resource "ory_identity_import" "%s" {
+  format = "csv"
+  source = <<-EOT
+    email,password_hash
+    %s-1@example.com,$2a$10$ZsCsoVQ3xfBG/K2z2XpBf.tm90GZmtOqtqWcB5.pYd5Eq8y7RlDyq
+    %s-2@example.com,$2a$10$ZsCsoVQ3xfBG/K2z2XpBf.tm90GZmtOqtqWcB5.pYd5Eq8y7RlDyq
+    %s-3@example.com,$2a$10$ZsCsoVQ3xfBG/K2z2XpBf.tm90GZmtOqtqWcB5.pYd5Eq8y7RlDyq
+  EOT
+}
+`, randomName, randomName, randomName, randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "imported_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "skipped_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "identity_ids.%", "3"),
+				),
+			},
+		},
+	})
+}
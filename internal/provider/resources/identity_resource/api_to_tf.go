@@ -0,0 +1,69 @@
+package identity_resource
+
+import (
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ory/client-go"
+)
+
+// ApiToIdentity maps the identity returned by the API onto the model.
+// Addresses are only refreshed when managed by the model, the password hash
+// is never returned and kept as is.
+func ApiToIdentity(identity *client.Identity, tfConfig *identityResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tfConfig.ID = types.StringValue(identity.Id)
+	tfConfig.SchemaID = types.StringValue(identity.SchemaId)
+	tfConfig.State = types.StringValue(identity.GetState())
+	tfConfig.Traits = jsonToTf(identity.Traits, &diags)
+
+	if len(identity.MetadataPublic) > 0 {
+		tfConfig.MetadataPublic = jsonToTf(identity.MetadataPublic, &diags)
+	} else {
+		tfConfig.MetadataPublic = jsontypes.NewNormalizedNull()
+	}
+
+	if len(identity.MetadataAdmin) > 0 {
+		tfConfig.MetadataAdmin = jsonToTf(identity.MetadataAdmin, &diags)
+	} else {
+		tfConfig.MetadataAdmin = jsontypes.NewNormalizedNull()
+	}
+
+	if tfConfig.VerifiableAddresses != nil {
+		addresses := []VerifiableAddress{}
+		for _, address := range identity.VerifiableAddresses {
+			addresses = append(addresses, VerifiableAddress{
+				Value:    types.StringValue(address.Value),
+				Via:      types.StringValue(address.Via),
+				Verified: types.BoolValue(address.Verified),
+			})
+		}
+		tfConfig.VerifiableAddresses = addresses
+	}
+
+	if tfConfig.RecoveryAddresses != nil {
+		addresses := []RecoveryAddress{}
+		for _, address := range identity.RecoveryAddresses {
+			addresses = append(addresses, RecoveryAddress{
+				Value: types.StringValue(address.Value),
+				Via:   types.StringValue(address.Via),
+			})
+		}
+		tfConfig.RecoveryAddresses = addresses
+	}
+
+	return diags
+}
+
+func jsonToTf(value interface{}, diags *diag.Diagnostics) jsontypes.Normalized {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		diags.AddError("Error encoding ORY identity", "Could not encode the identity: "+err.Error())
+		return jsontypes.NewNormalizedNull()
+	}
+
+	return jsontypes.NewNormalizedValue(string(encoded))
+}
@@ -0,0 +1,376 @@
+package identity_resource
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	oryclient "github.com/kibblator/terraform-provider-ory/internal/provider/clients"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/helpers"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &identityResource{}
+	_ resource.ResourceWithConfigure   = &identityResource{}
+	_ resource.ResourceWithImportState = &identityResource{}
+	_ resource.ResourceWithModifyPlan  = &identityResource{}
+)
+
+// NewIdentityResource is a helper function to simplify the provider implementation.
+func NewIdentityResource() resource.Resource {
+	return &identityResource{}
+}
+
+// identityResource is the resource implementation.
+type identityResource struct {
+	oryClient *oryclient.OryClient
+}
+
+type VerifiableAddress struct {
+	Value    types.String `tfsdk:"value"`
+	Via      types.String `tfsdk:"via"`
+	Verified types.Bool   `tfsdk:"verified"`
+}
+
+type RecoveryAddress struct {
+	Value types.String `tfsdk:"value"`
+	Via   types.String `tfsdk:"via"`
+}
+
+// identityResourceModel maps the resource schema data.
+type identityResourceModel struct {
+	ID                  types.String         `tfsdk:"id"`
+	LastUpdated         types.String         `tfsdk:"last_updated"`
+	SchemaID            types.String         `tfsdk:"schema_id"`
+	Traits              jsontypes.Normalized `tfsdk:"traits"`
+	MetadataPublic      jsontypes.Normalized `tfsdk:"metadata_public"`
+	MetadataAdmin       jsontypes.Normalized `tfsdk:"metadata_admin"`
+	State               types.String         `tfsdk:"state"`
+	VerifiableAddresses []VerifiableAddress  `tfsdk:"verifiable_addresses"`
+	RecoveryAddresses   []RecoveryAddress    `tfsdk:"recovery_addresses"`
+	PasswordHash        types.String         `tfsdk:"password_hash"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *identityResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*oryclient.OryClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected OryClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.oryClient = client
+}
+
+// Metadata returns the resource type name.
+func (r *identityResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_identity"
+}
+
+// Schema defines the schema for the resource.
+func (r *identityResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an identity of the project, such as a break-glass admin account or a test user.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The identity ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update of the identity.",
+				Computed:    true,
+			},
+			"schema_id": schema.StringAttribute{
+				Description: "ID of the identity schema the traits are validated against.",
+				Required:    true,
+			},
+			"traits": schema.StringAttribute{
+				Description: "Traits of the identity as a JSON object. They are validated against the identity schema at plan time.",
+				CustomType:  jsontypes.NormalizedType{},
+				Required:    true,
+			},
+			"metadata_public": schema.StringAttribute{
+				Description: "Metadata visible to the identity as a JSON object.",
+				CustomType:  jsontypes.NormalizedType{},
+				Optional:    true,
+			},
+			"metadata_admin": schema.StringAttribute{
+				Description: "Metadata only visible through the admin API as a JSON object.",
+				CustomType:  jsontypes.NormalizedType{},
+				Optional:    true,
+			},
+			"state": schema.StringAttribute{
+				Description: "State of the identity, `active` or `inactive`. Defaults to `active`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("active"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("active", "inactive"),
+				},
+			},
+			"verifiable_addresses": schema.SetNestedAttribute{
+				Description: "Verifiable addresses of the identity, for example to mark an email as verified. They must match the addresses derived from the traits.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"value": schema.StringAttribute{
+							Description: "The address, such as an email address.",
+							Required:    true,
+						},
+						"via": schema.StringAttribute{
+							Description: "The channel of the address, `email` or `sms`.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("email", "sms"),
+							},
+						},
+						"verified": schema.BoolAttribute{
+							Description: "If enabled, the address is verified.",
+							Required:    true,
+						},
+					},
+				},
+			},
+			"recovery_addresses": schema.SetNestedAttribute{
+				Description: "Recovery addresses of the identity. They must match the addresses derived from the traits.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"value": schema.StringAttribute{
+							Description: "The address, such as an email address.",
+							Required:    true,
+						},
+						"via": schema.StringAttribute{
+							Description: "The channel of the address, `email` or `sms`.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("email", "sms"),
+							},
+						},
+					},
+				},
+			},
+			"password_hash": schema.StringAttribute{
+				Description: "Hash of the password of the identity, imported as is. Supports the hash formats of Ory such as bcrypt, argon2 and PBKDF2. The hash is never read back from Ory.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+// ModifyPlan validates the traits against the referenced identity schema.
+func (r *identityResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.oryClient == nil {
+		return
+	}
+
+	var plan identityResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.SchemaID.IsUnknown() || plan.Traits.IsUnknown() {
+		return
+	}
+
+	identitySchema, _, err := r.oryClient.ProjectAPIClient.IdentityAPI.GetIdentitySchema(ctx, plan.SchemaID.ValueString()).Execute()
+
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("schema_id"),
+			"Unknown identity schema",
+			fmt.Sprintf("Could not retrieve the identity schema %q: %s", plan.SchemaID.ValueString(), oryclient.ErrorDetail(err)),
+		)
+		return
+	}
+
+	var traits interface{}
+	diags = plan.Traits.Unmarshal(&traits)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := helpers.ValidateTraits(identitySchema, traits); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("traits"),
+			"Invalid identity traits",
+			fmt.Sprintf("The traits don't match the identity schema %q: %s", plan.SchemaID.ValueString(), err),
+		)
+	}
+}
+
+// Create a new resource.
+func (r *identityResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan identityResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := IdentityToCreateApi(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	identity, _, err := r.oryClient.ProjectAPIClient.IdentityAPI.CreateIdentity(ctx).CreateIdentityBody(body).Execute()
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating ory identity",
+			"Could not create ory identity, unexpected error: "+oryclient.ErrorDetail(err),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	resp.Diagnostics.Append(ApiToIdentity(identity, &plan)...)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *identityResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading identity resource")
+
+	// Retrieve current state
+	var state identityResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	identity, httpResp, err := r.oryClient.ProjectAPIClient.IdentityAPI.GetIdentity(ctx, state.ID.ValueString()).Execute()
+
+	if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error fetching ORY identity",
+			"Could not retrieve ORY identity: "+oryclient.ErrorDetail(err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(ApiToIdentity(identity, &state)...)
+
+	// Set the updated state
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *identityResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve the desired state from the plan
+	var plan, state identityResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := IdentityToUpdateApi(plan, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	identityAPI := r.oryClient.ProjectAPIClient.IdentityAPI
+
+	identity, _, err := identityAPI.UpdateIdentity(ctx, plan.ID.ValueString()).UpdateIdentityBody(body).Execute()
+
+	if err == nil {
+		if patch := AddressesToApi(plan); len(patch) > 0 {
+			identity, _, err = identityAPI.PatchIdentity(ctx, plan.ID.ValueString()).JsonPatch(patch).Execute()
+		}
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating ory identity",
+			"Could not update ory identity, unexpected error: "+oryclient.ErrorDetail(err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(ApiToIdentity(identity, &plan)...)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set the updated plan to the state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *identityResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state identityResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.oryClient.ProjectAPIClient.IdentityAPI.DeleteIdentity(ctx, state.ID.ValueString()).Execute()
+
+	if err != nil && (httpResp == nil || httpResp.StatusCode != http.StatusNotFound) {
+		resp.Diagnostics.AddError(
+			"Error deleting ory identity",
+			"Could not delete ory identity, unexpected error: "+oryclient.ErrorDetail(err),
+		)
+		return
+	}
+}
+
+func (r *identityResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
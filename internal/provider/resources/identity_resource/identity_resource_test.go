@@ -0,0 +1,86 @@
+package identity_resource_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/kibblator/terraform-provider-ory/internal/provider/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOryIdentityResource(t *testing.T) {
+	randomName := acctest.GenerateRandomResourceName()
+	resourceName := fmt.Sprintf("ory_identity.%s", randomName)
+	email := fmt.Sprintf("%s@example.com", randomName)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			// Traits not matching the identity schema are rejected at plan time
+			{
+				Config: fmt.Sprintf(`
+resource "ory_identity" "%s" {
+  schema_id = "preset://email"
+  traits    = jsonencode({ name = "%s" })
+}
+`, randomName, randomName),
+				ExpectError: regexp.MustCompile("Invalid identity traits"),
+			},
+			// Create and Read testing
+			{
+				Config: fmt.Sprintf(`
+resource "ory_identity" "%s" {
+  schema_id = "preset://email"
+  traits    = jsonencode({ email = "%s" })
+
+  metadata_public = jsonencode({ team = "platform" })
+
+  verifiable_addresses = [
+    { value = "%s", via = "email", verified = true },
+  ]
+
+  password_hash = "$2a$10$ZsCsoVQ3xfBG/K2z2XpBf.tm90GZmtOqtqWcB5.pYd5Eq8y7RlDyq"
+}
+`, randomName, email, email),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "state", "active"),
+					resource.TestCheckResourceAttr(resourceName, "traits", fmt.Sprintf(`{"email":"%s"}`, email)),
+					resource.TestCheckResourceAttr(resourceName, "verifiable_addresses.0.verified", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_updated"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"last_updated",         // If 'last_updated' isn't returned from the ORY API, ignore it during import verification
+					"password_hash",        // The password hash is never returned by the API
+					"verifiable_addresses", // Addresses are only read when managed
+				},
+			},
+			// Update and Read testing
+			{
+				Config: fmt.Sprintf(`
+resource "ory_identity" "%s" {
+  schema_id = "preset://email"
+  traits    = jsonencode({ email = "%s" })
+  state     = "inactive"
+
+  metadata_admin = jsonencode({ role = "break-glass" })
+}
+`, randomName, email),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "state", "inactive"),
+					resource.TestCheckResourceAttr(resourceName, "metadata_admin", `{"role":"break-glass"}`),
+					resource.TestCheckNoResourceAttr(resourceName, "metadata_public"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,130 @@
+package identity_resource
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/ory/client-go"
+)
+
+// IdentityToCreateApi builds the identity creation request from the model.
+func IdentityToCreateApi(plan identityResourceModel) (client.CreateIdentityBody, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	body := client.CreateIdentityBody{
+		SchemaId:            plan.SchemaID.ValueString(),
+		State:               plan.State.ValueStringPointer(),
+		Credentials:         passwordToApi(plan),
+		VerifiableAddresses: verifiableAddressesToApi(plan.VerifiableAddresses),
+	}
+
+	diags.Append(plan.Traits.Unmarshal(&body.Traits)...)
+
+	if !plan.MetadataPublic.IsNull() {
+		diags.Append(plan.MetadataPublic.Unmarshal(&body.MetadataPublic)...)
+	}
+
+	if !plan.MetadataAdmin.IsNull() {
+		diags.Append(plan.MetadataAdmin.Unmarshal(&body.MetadataAdmin)...)
+	}
+
+	for _, address := range plan.RecoveryAddresses {
+		body.RecoveryAddresses = append(body.RecoveryAddresses, client.RecoveryIdentityAddress{
+			Value: address.Value.ValueString(),
+			Via:   address.Via.ValueString(),
+		})
+	}
+
+	return body, diags
+}
+
+// IdentityToUpdateApi builds the identity update request from the model. The
+// password is only sent when its hash changed.
+func IdentityToUpdateApi(plan identityResourceModel, state identityResourceModel) (client.UpdateIdentityBody, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	body := client.UpdateIdentityBody{
+		SchemaId: plan.SchemaID.ValueString(),
+		State:    plan.State.ValueString(),
+	}
+
+	if !plan.PasswordHash.Equal(state.PasswordHash) {
+		body.Credentials = passwordToApi(plan)
+	}
+
+	diags.Append(plan.Traits.Unmarshal(&body.Traits)...)
+
+	if !plan.MetadataPublic.IsNull() {
+		diags.Append(plan.MetadataPublic.Unmarshal(&body.MetadataPublic)...)
+	}
+
+	if !plan.MetadataAdmin.IsNull() {
+		diags.Append(plan.MetadataAdmin.Unmarshal(&body.MetadataAdmin)...)
+	}
+
+	return body, diags
+}
+
+// AddressesToApi returns the patch replacing the addresses managed by the
+// model, as addresses can't be set through an identity update.
+func AddressesToApi(plan identityResourceModel) []client.JsonPatch {
+	var patch []client.JsonPatch
+
+	if plan.VerifiableAddresses != nil {
+		patch = append(patch, client.JsonPatch{
+			Op:    "replace",
+			Path:  "/verifiable_addresses",
+			Value: verifiableAddressesToApi(plan.VerifiableAddresses),
+		})
+	}
+
+	if plan.RecoveryAddresses != nil {
+		addresses := []map[string]string{}
+		for _, address := range plan.RecoveryAddresses {
+			addresses = append(addresses, map[string]string{
+				"value": address.Value.ValueString(),
+				"via":   address.Via.ValueString(),
+			})
+		}
+
+		patch = append(patch, client.JsonPatch{
+			Op:    "replace",
+			Path:  "/recovery_addresses",
+			Value: addresses,
+		})
+	}
+
+	return patch
+}
+
+func verifiableAddressesToApi(addresses []VerifiableAddress) []client.VerifiableIdentityAddress {
+	var apiAddresses []client.VerifiableIdentityAddress
+
+	for _, address := range addresses {
+		status := "pending"
+		if address.Verified.ValueBool() {
+			status = "completed"
+		}
+
+		apiAddresses = append(apiAddresses, client.VerifiableIdentityAddress{
+			Value:    address.Value.ValueString(),
+			Via:      address.Via.ValueString(),
+			Verified: address.Verified.ValueBool(),
+			Status:   status,
+		})
+	}
+
+	return apiAddresses
+}
+
+func passwordToApi(plan identityResourceModel) *client.IdentityWithCredentials {
+	if plan.PasswordHash.IsNull() {
+		return nil
+	}
+
+	return &client.IdentityWithCredentials{
+		Password: &client.IdentityWithCredentialsPassword{
+			Config: &client.IdentityWithCredentialsPasswordConfig{
+				HashedPassword: plan.PasswordHash.ValueStringPointer(),
+			},
+		},
+	}
+}
@@ -0,0 +1,96 @@
+package json_web_key_set_resource
+
+import (
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ory/client-go"
+)
+
+// ApiToJsonWebKeySet maps the keys returned by the API onto the model. Keys of
+// the model missing from the API are dropped, and keys not in the model are
+// appended when includeUnmanaged is set.
+func ApiToJsonWebKeySet(keySet *client.JsonWebKeySet, tfConfig *jsonWebKeySetResourceModel, includeUnmanaged bool) (diags diag.Diagnostics) {
+	apiKeys := map[string]client.JsonWebKey{}
+	for _, apiKey := range keySet.Keys {
+		apiKeys[apiKey.Kid] = apiKey
+	}
+
+	keys := make([]jsonWebKeyModel, 0, len(keySet.Keys))
+	known := map[string]bool{}
+
+	for _, key := range tfConfig.Keys {
+		apiKey, found := apiKeys[key.Kid.ValueString()]
+
+		if !found {
+			continue
+		}
+
+		keys = append(keys, apiToKey(apiKey, &diags))
+		known[apiKey.Kid] = true
+	}
+
+	if includeUnmanaged {
+		for _, apiKey := range keySet.Keys {
+			if !known[apiKey.Kid] {
+				keys = append(keys, apiToKey(apiKey, &diags))
+				known[apiKey.Kid] = true
+			}
+		}
+	}
+
+	tfConfig.ID = tfConfig.SetID
+	tfConfig.Keys = keys
+
+	return diags
+}
+
+func apiToKey(apiKey client.JsonWebKey, diags *diag.Diagnostics) jsonWebKeyModel {
+	publicJWK, err := json.Marshal(PublicJWK(apiKey))
+
+	if err != nil {
+		diags.AddError(
+			"Error encoding ORY JSON Web Key",
+			"Could not encode the public key of "+apiKey.Kid+": "+err.Error(),
+		)
+	}
+
+	return jsonWebKeyModel{
+		Kid:          types.StringValue(apiKey.Kid),
+		Alg:          types.StringValue(apiKey.Alg),
+		Use:          types.StringValue(apiKey.Use),
+		PrivateJWKWO: types.StringNull(),
+		PublicJWK:    jsontypes.NewNormalizedValue(string(publicJWK)),
+	}
+}
+
+// PublicJWK returns the public parameters of a key, leaving out all private
+// key material returned by the admin API.
+func PublicJWK(apiKey client.JsonWebKey) map[string]interface{} {
+	jwk := map[string]interface{}{
+		"kty": apiKey.Kty,
+		"kid": apiKey.Kid,
+		"alg": apiKey.Alg,
+		"use": apiKey.Use,
+	}
+
+	for name, value := range map[string]*string{
+		"crv": apiKey.Crv,
+		"x":   apiKey.X,
+		"y":   apiKey.Y,
+		"n":   apiKey.N,
+		"e":   apiKey.E,
+	} {
+		if value != nil {
+			jwk[name] = *value
+		}
+	}
+
+	if len(apiKey.X5c) > 0 {
+		jwk["x5c"] = apiKey.X5c
+	}
+
+	return jwk
+}
@@ -0,0 +1,443 @@
+package json_web_key_set_resource
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	oryclient "github.com/kibblator/terraform-provider-ory/internal/provider/clients"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &jsonWebKeySetResource{}
+	_ resource.ResourceWithConfigure      = &jsonWebKeySetResource{}
+	_ resource.ResourceWithImportState    = &jsonWebKeySetResource{}
+	_ resource.ResourceWithValidateConfig = &jsonWebKeySetResource{}
+	_ resource.ResourceWithModifyPlan     = &jsonWebKeySetResource{}
+)
+
+// NewJsonWebKeySetResource is a helper function to simplify the provider implementation.
+func NewJsonWebKeySetResource() resource.Resource {
+	return &jsonWebKeySetResource{}
+}
+
+// jsonWebKeySetResource is the resource implementation.
+type jsonWebKeySetResource struct {
+	oryClient *oryclient.OryClient
+}
+
+type jsonWebKeyModel struct {
+	Kid          types.String         `tfsdk:"kid"`
+	Alg          types.String         `tfsdk:"alg"`
+	Use          types.String         `tfsdk:"use"`
+	PrivateJWKWO types.String         `tfsdk:"private_jwk_wo"`
+	PublicJWK    jsontypes.Normalized `tfsdk:"public_jwk"`
+}
+
+// jsonWebKeySetResourceModel maps the resource schema data.
+type jsonWebKeySetResourceModel struct {
+	ID          types.String      `tfsdk:"id"`
+	LastUpdated types.String      `tfsdk:"last_updated"`
+	SetID       types.String      `tfsdk:"set_id"`
+	Keys        []jsonWebKeyModel `tfsdk:"keys"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *jsonWebKeySetResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*oryclient.OryClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected OryClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.oryClient = client
+}
+
+// Metadata returns the resource type name.
+func (r *jsonWebKeySetResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_json_web_key_set"
+}
+
+// Schema defines the schema for the resource.
+func (r *jsonWebKeySetResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a JSON Web Key Set of the project, used for example to sign OAuth2 tokens or tokenized sessions. Keys are either generated by Ory or imported from a private JWK; only the public keys are kept in state. To rotate a key, add a key with a new `kid`, apply, and remove the old key afterwards. New keys are always added before old keys are removed.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "String identifier of the JSON Web Key Set, equal to the set ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update of the JSON Web Key Set.",
+				Computed:    true,
+			},
+			"set_id": schema.StringAttribute{
+				Description: "ID of the JSON Web Key Set, for example `hydra.openid.id-token`.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"keys": schema.ListNestedAttribute{
+				Description: "Keys of the set. A key is identified by its `kid`, changing the algorithm, use or private key of a key requires a new `kid`.",
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"kid": schema.StringAttribute{
+							Description: "ID of the key.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"alg": schema.StringAttribute{
+							Description: "Algorithm of the key, one of `RS256`, `ES256` or `EdDSA`. Required when Ory generates the key, taken from the private JWK otherwise.",
+							Optional:    true,
+							Computed:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(keyAlgorithms...),
+							},
+						},
+						"use": schema.StringAttribute{
+							Description: "Intended use of the key, either `sig` or `enc`. Defaults to `sig`.",
+							Optional:    true,
+							Computed:    true,
+							Default:     stringdefault.StaticString("sig"),
+							Validators: []validator.String{
+								stringvalidator.OneOf("sig", "enc"),
+							},
+						},
+						"private_jwk_wo": schema.StringAttribute{
+							Description: "JSON encoded private JWK to import instead of letting Ory generate the key. This value is write-only and never stored in state.",
+							Optional:    true,
+							Sensitive:   true,
+							WriteOnly:   true,
+						},
+						"public_jwk": schema.StringAttribute{
+							Description: "JSON encoded public JWK of the key.",
+							CustomType:  jsontypes.NormalizedType{},
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig ensures every key can either be generated or imported.
+func (r *jsonWebKeySetResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config jsonWebKeySetResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kids := map[string]bool{}
+
+	for i, key := range config.Keys {
+		keyPath := path.Root("keys").AtListIndex(i)
+
+		if !key.Kid.IsUnknown() && !key.Kid.IsNull() {
+			if kids[key.Kid.ValueString()] {
+				resp.Diagnostics.AddAttributeError(
+					keyPath.AtName("kid"),
+					"Duplicate key ID",
+					fmt.Sprintf("The key ID %q is used by several keys of the set.", key.Kid.ValueString()),
+				)
+			}
+
+			kids[key.Kid.ValueString()] = true
+		}
+
+		if key.PrivateJWKWO.IsUnknown() {
+			continue
+		}
+
+		if key.PrivateJWKWO.IsNull() {
+			if key.Alg.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					keyPath.AtName("alg"),
+					"Missing key algorithm",
+					"The algorithm is required for keys generated by Ory. Set alg or import a key with private_jwk_wo.",
+				)
+			}
+
+			continue
+		}
+
+		if _, err := PrivateJWKToApi(key); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				keyPath.AtName("private_jwk_wo"),
+				"Invalid private JWK",
+				err.Error(),
+			)
+		}
+	}
+}
+
+// ModifyPlan keeps the computed values of existing keys and rejects changes
+// that would modify a key in place.
+func (r *jsonWebKeySetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do when the resource is being created or destroyed
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state jsonWebKeySetResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(StateToPlannedKeys(state, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// Create a new resource.
+func (r *jsonWebKeySetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan, config jsonWebKeySetResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	WriteOnlyConfigToPlan(config, &plan)
+
+	err := r.addKeys(ctx, plan.SetID.ValueString(), plan.Keys)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating ory JSON Web Key Set",
+			"Could not create ory JSON Web Key Set, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	diags = r.refresh(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = plan.SetID
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *jsonWebKeySetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading JSON Web Key Set resource")
+
+	// Retrieve current state
+	var state jsonWebKeySetResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.SetID.IsNull() {
+		state.SetID = state.ID
+	}
+
+	keySet, httpResp, err := r.oryClient.ProjectAPIClient.JwkAPI.GetJsonWebKeySet(ctx, state.SetID.ValueString()).Execute()
+
+	if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error fetching ORY JSON Web Key Set",
+			"Could not retrieve ORY JSON Web Key Set: "+oryclient.ErrorDetail(err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(ApiToJsonWebKeySet(keySet, &state, true)...)
+
+	// Set the updated state
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update adds the new keys of the set before removing the keys no longer
+// configured, so signatures keep verifying during a rotation.
+func (r *jsonWebKeySetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve the desired state from the plan
+	var plan, state, config jsonWebKeySetResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	WriteOnlyConfigToPlan(config, &plan)
+
+	added, removed := KeyChanges(plan.Keys, state.Keys)
+	setID := plan.SetID.ValueString()
+
+	err := r.addKeys(ctx, setID, added)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating ory JSON Web Key Set",
+			"Could not add keys to ory JSON Web Key Set, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	for _, kid := range removed {
+		httpResp, err := r.oryClient.ProjectAPIClient.JwkAPI.DeleteJsonWebKey(ctx, setID, kid).Execute()
+
+		if err != nil && (httpResp == nil || httpResp.StatusCode != http.StatusNotFound) {
+			resp.Diagnostics.AddError(
+				"Error updating ory JSON Web Key Set",
+				fmt.Sprintf("Could not remove key %q from ory JSON Web Key Set, unexpected error: %s", kid, oryclient.ErrorDetail(err)),
+			)
+			return
+		}
+	}
+
+	diags = r.refresh(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set the updated plan to the state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *jsonWebKeySetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state jsonWebKeySetResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.oryClient.ProjectAPIClient.JwkAPI.DeleteJsonWebKeySet(ctx, state.SetID.ValueString()).Execute()
+
+	if err != nil && (httpResp == nil || httpResp.StatusCode != http.StatusNotFound) {
+		resp.Diagnostics.AddError(
+			"Error deleting ory JSON Web Key Set",
+			"Could not delete ory JSON Web Key Set, unexpected error: "+oryclient.ErrorDetail(err),
+		)
+		return
+	}
+}
+
+func (r *jsonWebKeySetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id and set_id attributes
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughID(ctx, path.Root("set_id"), req, resp)
+}
+
+// addKeys generates or imports the given keys into the set.
+func (r *jsonWebKeySetResource) addKeys(ctx context.Context, setID string, keys []jsonWebKeyModel) error {
+	jwkAPI := r.oryClient.ProjectAPIClient.JwkAPI
+
+	for _, key := range keys {
+		if key.PrivateJWKWO.IsNull() {
+			_, _, err := jwkAPI.CreateJsonWebKeySet(ctx, setID).CreateJsonWebKeySet(GeneratedKeyToApi(key)).Execute()
+			if err != nil {
+				return fmt.Errorf("failed to generate key %q: %s", key.Kid.ValueString(), oryclient.ErrorDetail(err))
+			}
+
+			continue
+		}
+
+		jwk, err := PrivateJWKToApi(key)
+		if err != nil {
+			return fmt.Errorf("failed to import key %q: %w", key.Kid.ValueString(), err)
+		}
+
+		_, _, err = jwkAPI.SetJsonWebKey(ctx, setID, jwk.Kid).JsonWebKey(*jwk).Execute()
+		if err != nil {
+			return fmt.Errorf("failed to import key %q: %s", key.Kid.ValueString(), oryclient.ErrorDetail(err))
+		}
+	}
+
+	return nil
+}
+
+// refresh reads the public keys of the set back into the model.
+func (r *jsonWebKeySetResource) refresh(ctx context.Context, tfConfig *jsonWebKeySetResourceModel) (diags diag.Diagnostics) {
+	keySet, _, err := r.oryClient.ProjectAPIClient.JwkAPI.GetJsonWebKeySet(ctx, tfConfig.SetID.ValueString()).Execute()
+
+	if err != nil {
+		diags.AddError(
+			"Error fetching ORY JSON Web Key Set",
+			"Could not retrieve ORY JSON Web Key Set: "+oryclient.ErrorDetail(err),
+		)
+		return diags
+	}
+
+	return ApiToJsonWebKeySet(keySet, tfConfig, false)
+}
@@ -0,0 +1,83 @@
+package json_web_key_set_resource_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kibblator/terraform-provider-ory/internal/provider/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOryJsonWebKeySetResource(t *testing.T) {
+	randomName := acctest.GenerateRandomResourceName()
+	resourceName := fmt.Sprintf("ory_json_web_key_set.%s", randomName)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccOryJsonWebKeySet(randomName, `
+    {
+      kid = "%[1]s-1"
+      alg = "RS256"
+    },`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "set_id", randomName),
+					resource.TestCheckResourceAttr(resourceName, "keys.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "keys.0.use", "sig"),
+					resource.TestCheckResourceAttrSet(resourceName, "keys.0.public_jwk"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_updated"),
+				),
+			},
+			// Rotation: the new key is added before the old one is removed
+			{
+				Config: testAccOryJsonWebKeySet(randomName, `
+    {
+      kid = "%[1]s-1"
+      alg = "RS256"
+    },
+    {
+      kid = "%[1]s-2"
+      alg = "ES256"
+    },`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "keys.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "keys.1.alg", "ES256"),
+				),
+			},
+			{
+				Config: testAccOryJsonWebKeySet(randomName, `
+    {
+      kid = "%[1]s-2"
+      alg = "ES256"
+    },`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "keys.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "keys.0.kid", randomName+"-2"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"last_updated", // If 'last_updated' isn't returned from the ORY API, ignore it during import verification
+				},
+			},
+		},
+	})
+}
+
+func testAccOryJsonWebKeySet(randomName string, keys string) string {
+	return fmt.Sprintf(`
+resource "ory_json_web_key_set" "%[1]s" {
+  set_id = "%[1]s"
+  keys = [`+keys+`
+  ]
+}
+`, randomName)
+}
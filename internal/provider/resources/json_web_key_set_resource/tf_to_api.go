@@ -0,0 +1,159 @@
+package json_web_key_set_resource
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ory/client-go"
+)
+
+var keyAlgorithms = []string{"RS256", "ES256", "EdDSA"}
+
+// GeneratedKeyToApi maps a key generated by Ory onto the creation body.
+func GeneratedKeyToApi(key jsonWebKeyModel) client.CreateJsonWebKeySet {
+	return client.CreateJsonWebKeySet{
+		Alg: key.Alg.ValueString(),
+		Kid: key.Kid.ValueString(),
+		Use: key.Use.ValueString(),
+	}
+}
+
+// PrivateJWKToApi parses the private JWK of an imported key, filling in the
+// key ID, algorithm and use from the key when the JWK doesn't set them.
+func PrivateJWKToApi(key jsonWebKeyModel) (*client.JsonWebKey, error) {
+	var jwk map[string]interface{}
+	if err := json.Unmarshal([]byte(key.PrivateJWKWO.ValueString()), &jwk); err != nil {
+		return nil, fmt.Errorf("the private JWK is not valid JSON: %w", err)
+	}
+
+	if _, ok := jwk["kty"].(string); !ok {
+		return nil, fmt.Errorf("the private JWK has no key type (kty)")
+	}
+
+	if _, ok := jwk["d"].(string); !ok {
+		return nil, fmt.Errorf("the JWK has no private key (d), only private keys can be imported")
+	}
+
+	for _, field := range []struct {
+		name  string
+		value types.String
+	}{
+		{"kid", key.Kid},
+		{"alg", key.Alg},
+		{"use", key.Use},
+	} {
+		jwkValue, found := jwk[field.name].(string)
+
+		if field.value.IsNull() || field.value.IsUnknown() {
+			continue
+		}
+
+		if found && jwkValue != field.value.ValueString() {
+			return nil, fmt.Errorf("the %s of the private JWK is %q, but the key sets %q", field.name, jwkValue, field.value.ValueString())
+		}
+
+		jwk[field.name] = field.value.ValueString()
+	}
+
+	if alg, _ := jwk["alg"].(string); !slices.Contains(keyAlgorithms, alg) {
+		return nil, fmt.Errorf("the algorithm of the private JWK must be one of %v, got %q", keyAlgorithms, alg)
+	}
+
+	if _, ok := jwk["use"]; !ok {
+		jwk["use"] = "sig"
+	}
+
+	encoded, err := json.Marshal(jwk)
+	if err != nil {
+		return nil, err
+	}
+
+	var apiKey client.JsonWebKey
+	if err := json.Unmarshal(encoded, &apiKey); err != nil {
+		return nil, fmt.Errorf("the private JWK is invalid: %w", err)
+	}
+
+	return &apiKey, nil
+}
+
+// WriteOnlyConfigToPlan copies the write-only private JWKs from the
+// configuration into the plan, as the framework always nulls them out in the
+// plan itself.
+func WriteOnlyConfigToPlan(config jsonWebKeySetResourceModel, plan *jsonWebKeySetResourceModel) {
+	for i := range plan.Keys {
+		if i < len(config.Keys) {
+			plan.Keys[i].PrivateJWKWO = config.Keys[i].PrivateJWKWO
+		}
+	}
+}
+
+// KeyChanges returns the planned keys missing from the current keys, and the
+// IDs of the current keys no longer planned.
+func KeyChanges(planned []jsonWebKeyModel, current []jsonWebKeyModel) (added []jsonWebKeyModel, removed []string) {
+	currentKids := map[string]bool{}
+	for _, key := range current {
+		currentKids[key.Kid.ValueString()] = true
+	}
+
+	plannedKids := map[string]bool{}
+	for _, key := range planned {
+		plannedKids[key.Kid.ValueString()] = true
+
+		if !currentKids[key.Kid.ValueString()] {
+			added = append(added, key)
+		}
+	}
+
+	for _, key := range current {
+		if !plannedKids[key.Kid.ValueString()] {
+			removed = append(removed, key.Kid.ValueString())
+		}
+	}
+
+	return added, removed
+}
+
+// StateToPlannedKeys carries the computed values of keys that already exist
+// over to the plan. Existing keys can't be modified in place, so a changed
+// algorithm or use is reported as an error.
+func StateToPlannedKeys(state jsonWebKeySetResourceModel, plan *jsonWebKeySetResourceModel) (diags diag.Diagnostics) {
+	stateKeys := map[string]jsonWebKeyModel{}
+	for _, key := range state.Keys {
+		stateKeys[key.Kid.ValueString()] = key
+	}
+
+	for i, key := range plan.Keys {
+		stateKey, found := stateKeys[key.Kid.ValueString()]
+
+		if !found {
+			continue
+		}
+
+		keyPath := path.Root("keys").AtListIndex(i)
+
+		if !key.Alg.IsUnknown() && !key.Alg.Equal(stateKey.Alg) {
+			diags.AddAttributeError(
+				keyPath.AtName("alg"),
+				"Existing key can't be changed",
+				fmt.Sprintf("The algorithm of key %q can't be changed. Add a key with a new kid to rotate it.", key.Kid.ValueString()),
+			)
+		}
+
+		if !key.Use.IsUnknown() && !key.Use.Equal(stateKey.Use) {
+			diags.AddAttributeError(
+				keyPath.AtName("use"),
+				"Existing key can't be changed",
+				fmt.Sprintf("The use of key %q can't be changed. Add a key with a new kid to rotate it.", key.Kid.ValueString()),
+			)
+		}
+
+		plan.Keys[i].Alg = stateKey.Alg
+		plan.Keys[i].PublicJWK = stateKey.PublicJWK
+	}
+
+	return diags
+}
@@ -0,0 +1,118 @@
+package oauth2_client_resource
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/helpers"
+	"github.com/ory/client-go"
+)
+
+// ApiToOAuth2Client maps the OAuth2 client returned by the API onto the model.
+// The client secret is only returned on creation, so the value in the model is
+// kept otherwise.
+func ApiToOAuth2Client(ctx context.Context, oauth2Client *client.OAuth2Client, tfConfig *oauth2ClientResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tfConfig.ID = types.StringValue(oauth2Client.GetClientId())
+	tfConfig.ClientName = helpers.StringOrNil(oauth2Client.GetClientName())
+
+	if secret := oauth2Client.GetClientSecret(); secret != "" {
+		tfConfig.ClientSecret = types.StringValue(secret)
+	} else if tfConfig.ClientSecret.IsUnknown() {
+		tfConfig.ClientSecret = types.StringNull()
+	}
+
+	grantTypes, d := types.ListValueFrom(ctx, types.StringType, oauth2Client.GetGrantTypes())
+	diags.Append(d...)
+	tfConfig.GrantTypes = grantTypes
+
+	responseTypes, d := types.ListValueFrom(ctx, types.StringType, oauth2Client.GetResponseTypes())
+	diags.Append(d...)
+	tfConfig.ResponseTypes = responseTypes
+
+	tfConfig.RedirectURIs = stringsToTf(oauth2Client.GetRedirectUris(), tfConfig.RedirectURIs)
+	tfConfig.PostLogoutRedirectURIs = stringsToTf(oauth2Client.GetPostLogoutRedirectUris(), tfConfig.PostLogoutRedirectURIs)
+	tfConfig.Audience = stringsToTf(oauth2Client.GetAudience(), tfConfig.Audience)
+	tfConfig.AllowedCorsOrigins = stringsToTf(oauth2Client.GetAllowedCorsOrigins(), tfConfig.AllowedCorsOrigins)
+
+	tfConfig.Scope = types.StringValue(oauth2Client.GetScope())
+	tfConfig.TokenEndpointAuthMethod = types.StringValue(oauth2Client.GetTokenEndpointAuthMethod())
+	tfConfig.TokenEndpointAuthSigningAlg = helpers.StringOrNil(oauth2Client.GetTokenEndpointAuthSigningAlg())
+	tfConfig.AccessTokenStrategy = types.StringValue(oauth2Client.GetAccessTokenStrategy())
+	tfConfig.JwksURI = helpers.StringOrNil(oauth2Client.GetJwksUri())
+	tfConfig.SkipConsent = types.BoolValue(oauth2Client.GetSkipConsent())
+	tfConfig.SkipLogoutConsent = types.BoolValue(oauth2Client.GetSkipLogoutConsent())
+	tfConfig.Owner = helpers.StringOrNil(oauth2Client.GetOwner())
+	tfConfig.ClientURI = helpers.StringOrNil(oauth2Client.GetClientUri())
+	tfConfig.LogoURI = helpers.StringOrNil(oauth2Client.GetLogoUri())
+
+	tfConfig.Jwks = jsonToTf(oauth2Client.Jwks, &diags)
+
+	if len(oauth2Client.Metadata) > 0 {
+		tfConfig.Metadata = jsonToTf(oauth2Client.Metadata, &diags)
+	} else {
+		tfConfig.Metadata = jsontypes.NewNormalizedNull()
+	}
+
+	lifespans := Lifespans{}
+	if tfConfig.Lifespans != nil {
+		lifespans = *tfConfig.Lifespans
+	}
+
+	lifespans.AuthorizationCodeGrantAccessToken = lifespanToTf(oauth2Client.AuthorizationCodeGrantAccessTokenLifespan, lifespans.AuthorizationCodeGrantAccessToken)
+	lifespans.AuthorizationCodeGrantIDToken = lifespanToTf(oauth2Client.AuthorizationCodeGrantIdTokenLifespan, lifespans.AuthorizationCodeGrantIDToken)
+	lifespans.AuthorizationCodeGrantRefreshToken = lifespanToTf(oauth2Client.AuthorizationCodeGrantRefreshTokenLifespan, lifespans.AuthorizationCodeGrantRefreshToken)
+	lifespans.ClientCredentialsGrantAccessToken = lifespanToTf(oauth2Client.ClientCredentialsGrantAccessTokenLifespan, lifespans.ClientCredentialsGrantAccessToken)
+	lifespans.ImplicitGrantAccessToken = lifespanToTf(oauth2Client.ImplicitGrantAccessTokenLifespan, lifespans.ImplicitGrantAccessToken)
+	lifespans.ImplicitGrantIDToken = lifespanToTf(oauth2Client.ImplicitGrantIdTokenLifespan, lifespans.ImplicitGrantIDToken)
+	lifespans.JwtBearerGrantAccessToken = lifespanToTf(oauth2Client.JwtBearerGrantAccessTokenLifespan, lifespans.JwtBearerGrantAccessToken)
+	lifespans.RefreshTokenGrantAccessToken = lifespanToTf(oauth2Client.RefreshTokenGrantAccessTokenLifespan, lifespans.RefreshTokenGrantAccessToken)
+	lifespans.RefreshTokenGrantIDToken = lifespanToTf(oauth2Client.RefreshTokenGrantIdTokenLifespan, lifespans.RefreshTokenGrantIDToken)
+	lifespans.RefreshTokenGrantRefreshToken = lifespanToTf(oauth2Client.RefreshTokenGrantRefreshTokenLifespan, lifespans.RefreshTokenGrantRefreshToken)
+
+	if tfConfig.Lifespans != nil || lifespans != (Lifespans{}) {
+		tfConfig.Lifespans = &lifespans
+	}
+
+	return diags
+}
+
+func lifespanToTf(value client.NullableString, state types.String) types.String {
+	if value.Get() == nil {
+		return types.StringNull()
+	}
+
+	return helpers.DurationOrState(*value.Get(), state)
+}
+
+func jsonToTf(value interface{}, diags *diag.Diagnostics) jsontypes.Normalized {
+	if value == nil {
+		return jsontypes.NewNormalizedNull()
+	}
+
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		diags.AddError("Error encoding ORY OAuth2 client", "Could not encode the OAuth2 client: "+err.Error())
+		return jsontypes.NewNormalizedNull()
+	}
+
+	return jsontypes.NewNormalizedValue(string(encoded))
+}
+
+// stringsToTf keeps unset lists null when the API returns them empty.
+func stringsToTf(values []string, state []types.String) []types.String {
+	if len(values) == 0 && state == nil {
+		return nil
+	}
+
+	tfValues := make([]types.String, 0, len(values))
+	for _, value := range values {
+		tfValues = append(tfValues, types.StringValue(value))
+	}
+
+	return tfValues
+}
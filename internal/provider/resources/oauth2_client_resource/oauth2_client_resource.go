@@ -0,0 +1,437 @@
+package oauth2_client_resource
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	oryclient "github.com/kibblator/terraform-provider-ory/internal/provider/clients"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/custom_validators"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &oauth2ClientResource{}
+	_ resource.ResourceWithConfigure   = &oauth2ClientResource{}
+	_ resource.ResourceWithImportState = &oauth2ClientResource{}
+)
+
+// NewOAuth2ClientResource is a helper function to simplify the provider implementation.
+func NewOAuth2ClientResource() resource.Resource {
+	return &oauth2ClientResource{}
+}
+
+// oauth2ClientResource is the resource implementation.
+type oauth2ClientResource struct {
+	oryClient *oryclient.OryClient
+}
+
+type Lifespans struct {
+	AuthorizationCodeGrantAccessToken  types.String `tfsdk:"authorization_code_grant_access_token"`
+	AuthorizationCodeGrantIDToken      types.String `tfsdk:"authorization_code_grant_id_token"`
+	AuthorizationCodeGrantRefreshToken types.String `tfsdk:"authorization_code_grant_refresh_token"`
+	ClientCredentialsGrantAccessToken  types.String `tfsdk:"client_credentials_grant_access_token"`
+	ImplicitGrantAccessToken           types.String `tfsdk:"implicit_grant_access_token"`
+	ImplicitGrantIDToken               types.String `tfsdk:"implicit_grant_id_token"`
+	JwtBearerGrantAccessToken          types.String `tfsdk:"jwt_bearer_grant_access_token"`
+	RefreshTokenGrantAccessToken       types.String `tfsdk:"refresh_token_grant_access_token"`
+	RefreshTokenGrantIDToken           types.String `tfsdk:"refresh_token_grant_id_token"`
+	RefreshTokenGrantRefreshToken      types.String `tfsdk:"refresh_token_grant_refresh_token"`
+}
+
+// oauth2ClientResourceModel maps the resource schema data.
+type oauth2ClientResourceModel struct {
+	ID                          types.String         `tfsdk:"id"`
+	LastUpdated                 types.String         `tfsdk:"last_updated"`
+	ClientName                  types.String         `tfsdk:"client_name"`
+	ClientSecret                types.String         `tfsdk:"client_secret"`
+	GrantTypes                  types.List           `tfsdk:"grant_types"`
+	ResponseTypes               types.List           `tfsdk:"response_types"`
+	RedirectURIs                []types.String       `tfsdk:"redirect_uris"`
+	PostLogoutRedirectURIs      []types.String       `tfsdk:"post_logout_redirect_uris"`
+	Scope                       types.String         `tfsdk:"scope"`
+	Audience                    []types.String       `tfsdk:"audience"`
+	AllowedCorsOrigins          []types.String       `tfsdk:"allowed_cors_origins"`
+	TokenEndpointAuthMethod     types.String         `tfsdk:"token_endpoint_auth_method"`
+	TokenEndpointAuthSigningAlg types.String         `tfsdk:"token_endpoint_auth_signing_alg"`
+	AccessTokenStrategy         types.String         `tfsdk:"access_token_strategy"`
+	JwksURI                     types.String         `tfsdk:"jwks_uri"`
+	Jwks                        jsontypes.Normalized `tfsdk:"jwks"`
+	SkipConsent                 types.Bool           `tfsdk:"skip_consent"`
+	SkipLogoutConsent           types.Bool           `tfsdk:"skip_logout_consent"`
+	Owner                       types.String         `tfsdk:"owner"`
+	ClientURI                   types.String         `tfsdk:"client_uri"`
+	LogoURI                     types.String         `tfsdk:"logo_uri"`
+	Lifespans                   *Lifespans           `tfsdk:"lifespans"`
+	Metadata                    jsontypes.Normalized `tfsdk:"metadata"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *oauth2ClientResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*oryclient.OryClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected OryClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.oryClient = client
+}
+
+// Metadata returns the resource type name.
+func (r *oauth2ClientResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_oauth2_client"
+}
+
+func lifespanAttribute(description string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: description,
+		Optional:    true,
+		Validators: []validator.String{
+			custom_validators.DurationValidator{},
+		},
+	}
+}
+
+func uriListAttribute(description string) schema.ListAttribute {
+	return schema.ListAttribute{
+		Description: description,
+		ElementType: types.StringType,
+		Optional:    true,
+		Validators: []validator.List{
+			listvalidator.ValueStringsAre(custom_validators.URLValidator{Schemes: []string{"http", "https"}}),
+		},
+	}
+}
+
+// Schema defines the schema for the resource.
+func (r *oauth2ClientResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an OAuth2 client of the project.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The client ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update of the OAuth2 client.",
+				Computed:    true,
+			},
+			"client_name": schema.StringAttribute{
+				Description: "Human-readable name of the client, shown to users during consent.",
+				Optional:    true,
+			},
+			"client_secret": schema.StringAttribute{
+				Description: "The client secret. Generated by Ory unless set. Not available for imported clients.",
+				Optional:    true,
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"grant_types": schema.ListAttribute{
+				Description: "Grant types the client may use, such as `authorization_code`, `refresh_token` or `client_credentials`.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.OneOf(
+						"authorization_code",
+						"implicit",
+						"client_credentials",
+						"refresh_token",
+						"urn:ietf:params:oauth:grant-type:jwt-bearer",
+						"urn:ietf:params:oauth:grant-type:device_code",
+					)),
+				},
+			},
+			"response_types": schema.ListAttribute{
+				Description: "Response types the client may use, such as `code` or `id_token`.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"redirect_uris":             uriListAttribute("Allowed redirect URIs."),
+			"post_logout_redirect_uris": uriListAttribute("Allowed redirect URIs after logout."),
+			"scope": schema.StringAttribute{
+				Description: "Space separated list of scopes the client may request.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"audience": schema.ListAttribute{
+				Description: "Audiences the client may request tokens for.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"allowed_cors_origins": schema.ListAttribute{
+				Description: "Origins allowed to make CORS requests on behalf of the client.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(custom_validators.OriginValidator{}),
+				},
+			},
+			"token_endpoint_auth_method": schema.StringAttribute{
+				Description: "How the client authenticates at the token endpoint.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("client_secret_basic", "client_secret_post", "private_key_jwt", "none"),
+				},
+			},
+			"token_endpoint_auth_signing_alg": schema.StringAttribute{
+				Description: "Algorithm the client signs JWTs with when using `private_key_jwt`.",
+				Optional:    true,
+			},
+			"access_token_strategy": schema.StringAttribute{
+				Description: "Format of the access tokens issued to the client, `jwt` or `opaque`. Defaults to the project setting.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("jwt", "opaque"),
+				},
+			},
+			"jwks_uri": schema.StringAttribute{
+				Description: "URL of the client's JSON Web Key Set.",
+				Optional:    true,
+				Validators: []validator.String{
+					custom_validators.URLValidator{Schemes: []string{"http", "https"}},
+					stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("jwks")),
+				},
+			},
+			"jwks": schema.StringAttribute{
+				Description: "The client's JSON Web Key Set as a JSON document.",
+				CustomType:  jsontypes.NormalizedType{},
+				Optional:    true,
+			},
+			"skip_consent": schema.BoolAttribute{
+				Description: "If enabled, the consent screen is skipped for this client.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"skip_logout_consent": schema.BoolAttribute{
+				Description: "If enabled, the logout consent screen is skipped for this client.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"owner": schema.StringAttribute{
+				Description: "Owner of the client.",
+				Optional:    true,
+			},
+			"client_uri": schema.StringAttribute{
+				Description: "URL of the client's home page.",
+				Optional:    true,
+			},
+			"logo_uri": schema.StringAttribute{
+				Description: "URL of the client's logo.",
+				Optional:    true,
+			},
+			"lifespans": schema.SingleNestedAttribute{
+				Description: "Token lifespans overriding the project defaults, as durations such as `1h`.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"authorization_code_grant_access_token":  lifespanAttribute("Access token lifespan of the authorization code grant."),
+					"authorization_code_grant_id_token":      lifespanAttribute("ID token lifespan of the authorization code grant."),
+					"authorization_code_grant_refresh_token": lifespanAttribute("Refresh token lifespan of the authorization code grant."),
+					"client_credentials_grant_access_token":  lifespanAttribute("Access token lifespan of the client credentials grant."),
+					"implicit_grant_access_token":            lifespanAttribute("Access token lifespan of the implicit grant."),
+					"implicit_grant_id_token":                lifespanAttribute("ID token lifespan of the implicit grant."),
+					"jwt_bearer_grant_access_token":          lifespanAttribute("Access token lifespan of the JWT bearer grant."),
+					"refresh_token_grant_access_token":       lifespanAttribute("Access token lifespan of the refresh token grant."),
+					"refresh_token_grant_id_token":           lifespanAttribute("ID token lifespan of the refresh token grant."),
+					"refresh_token_grant_refresh_token":      lifespanAttribute("Refresh token lifespan of the refresh token grant."),
+				},
+			},
+			"metadata": schema.StringAttribute{
+				Description: "Arbitrary metadata of the client as a JSON object.",
+				CustomType:  jsontypes.NormalizedType{},
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// Create a new resource.
+func (r *oauth2ClientResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan oauth2ClientResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := OAuth2ClientToApi(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	oauth2Client, _, err := r.oryClient.ProjectAPIClient.OAuth2API.CreateOAuth2Client(ctx).OAuth2Client(body).Execute()
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating ory OAuth2 client",
+			"Could not create ory OAuth2 client, unexpected error: "+oryclient.ErrorDetail(err),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	resp.Diagnostics.Append(ApiToOAuth2Client(ctx, oauth2Client, &plan)...)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *oauth2ClientResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading OAuth2 client resource")
+
+	// Retrieve current state
+	var state oauth2ClientResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	oauth2Client, httpResp, err := r.oryClient.ProjectAPIClient.OAuth2API.GetOAuth2Client(ctx, state.ID.ValueString()).Execute()
+
+	if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error fetching ORY OAuth2 client",
+			"Could not retrieve ORY OAuth2 client: "+oryclient.ErrorDetail(err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(ApiToOAuth2Client(ctx, oauth2Client, &state)...)
+
+	// Set the updated state
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *oauth2ClientResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve the desired state from the plan
+	var plan oauth2ClientResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, diags := OAuth2ClientToApi(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	oauth2Client, _, err := r.oryClient.ProjectAPIClient.OAuth2API.SetOAuth2Client(ctx, plan.ID.ValueString()).OAuth2Client(body).Execute()
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating ory OAuth2 client",
+			"Could not update ory OAuth2 client, unexpected error: "+oryclient.ErrorDetail(err),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(ApiToOAuth2Client(ctx, oauth2Client, &plan)...)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set the updated plan to the state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *oauth2ClientResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state oauth2ClientResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.oryClient.ProjectAPIClient.OAuth2API.DeleteOAuth2Client(ctx, state.ID.ValueString()).Execute()
+
+	if err != nil && (httpResp == nil || httpResp.StatusCode != http.StatusNotFound) {
+		resp.Diagnostics.AddError(
+			"Error deleting ory OAuth2 client",
+			"Could not delete ory OAuth2 client, unexpected error: "+oryclient.ErrorDetail(err),
+		)
+		return
+	}
+}
+
+func (r *oauth2ClientResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,78 @@
+package oauth2_client_resource_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kibblator/terraform-provider-ory/internal/provider/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOryOAuth2ClientResource(t *testing.T) {
+	randomName := acctest.GenerateRandomResourceName()
+	resourceName := fmt.Sprintf("ory_oauth2_client.%s", randomName)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: fmt.Sprintf(`
+resource "ory_oauth2_client" "%s" {
+  client_name    = "%s"
+  grant_types    = ["authorization_code", "refresh_token"]
+  response_types = ["code"]
+  scope          = "openid offline_access"
+  redirect_uris  = ["https://app.example.com/callback"]
+
+  lifespans = {
+    authorization_code_grant_access_token = "1h"
+  }
+}
+`, randomName, randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "client_secret"),
+					resource.TestCheckResourceAttr(resourceName, "client_name", randomName),
+					resource.TestCheckResourceAttr(resourceName, "grant_types.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "scope", "openid offline_access"),
+					resource.TestCheckResourceAttr(resourceName, "lifespans.authorization_code_grant_access_token", "1h"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_updated"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"last_updated",  // If 'last_updated' isn't returned from the ORY API, ignore it during import verification
+					"client_secret", // The client secret is only returned when the client is created
+				},
+			},
+			// Update and Read testing
+			{
+				Config: fmt.Sprintf(`
+resource "ory_oauth2_client" "%s" {
+  client_name                = "%s"
+  grant_types                = ["client_credentials"]
+  token_endpoint_auth_method = "client_secret_post"
+  audience                   = ["https://api.example.com"]
+
+  metadata = jsonencode({
+    team = "platform"
+  })
+}
+`, randomName, randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "grant_types.0", "client_credentials"),
+					resource.TestCheckResourceAttr(resourceName, "token_endpoint_auth_method", "client_secret_post"),
+					resource.TestCheckResourceAttr(resourceName, "audience.0", "https://api.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "metadata", `{"team":"platform"}`),
+				),
+			},
+		},
+	})
+}
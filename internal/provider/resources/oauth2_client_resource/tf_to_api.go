@@ -0,0 +1,109 @@
+package oauth2_client_resource
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ory/client-go"
+)
+
+// OAuth2ClientToApi builds the OAuth2 client request body from the model.
+func OAuth2ClientToApi(ctx context.Context, tfConfig oauth2ClientResourceModel) (client.OAuth2Client, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	body := client.OAuth2Client{
+		ClientName:                  tfConfig.ClientName.ValueStringPointer(),
+		RedirectUris:                stringsToApi(tfConfig.RedirectURIs),
+		PostLogoutRedirectUris:      stringsToApi(tfConfig.PostLogoutRedirectURIs),
+		Audience:                    stringsToApi(tfConfig.Audience),
+		AllowedCorsOrigins:          stringsToApi(tfConfig.AllowedCorsOrigins),
+		TokenEndpointAuthSigningAlg: tfConfig.TokenEndpointAuthSigningAlg.ValueStringPointer(),
+		JwksUri:                     tfConfig.JwksURI.ValueStringPointer(),
+		Owner:                       tfConfig.Owner.ValueStringPointer(),
+		ClientUri:                   tfConfig.ClientURI.ValueStringPointer(),
+		LogoUri:                     tfConfig.LogoURI.ValueStringPointer(),
+	}
+
+	if knownString(tfConfig.ClientSecret) {
+		body.ClientSecret = tfConfig.ClientSecret.ValueStringPointer()
+	}
+
+	if knownString(tfConfig.Scope) {
+		body.Scope = tfConfig.Scope.ValueStringPointer()
+	}
+
+	if knownString(tfConfig.TokenEndpointAuthMethod) {
+		body.TokenEndpointAuthMethod = tfConfig.TokenEndpointAuthMethod.ValueStringPointer()
+	}
+
+	if knownString(tfConfig.AccessTokenStrategy) {
+		body.AccessTokenStrategy = tfConfig.AccessTokenStrategy.ValueStringPointer()
+	}
+
+	if !tfConfig.SkipConsent.IsNull() && !tfConfig.SkipConsent.IsUnknown() {
+		body.SkipConsent = tfConfig.SkipConsent.ValueBoolPointer()
+	}
+
+	if !tfConfig.SkipLogoutConsent.IsNull() && !tfConfig.SkipLogoutConsent.IsUnknown() {
+		body.SkipLogoutConsent = tfConfig.SkipLogoutConsent.ValueBoolPointer()
+	}
+
+	if !tfConfig.GrantTypes.IsNull() && !tfConfig.GrantTypes.IsUnknown() {
+		diags.Append(tfConfig.GrantTypes.ElementsAs(ctx, &body.GrantTypes, false)...)
+	}
+
+	if !tfConfig.ResponseTypes.IsNull() && !tfConfig.ResponseTypes.IsUnknown() {
+		diags.Append(tfConfig.ResponseTypes.ElementsAs(ctx, &body.ResponseTypes, false)...)
+	}
+
+	if !tfConfig.Jwks.IsNull() {
+		diags.Append(tfConfig.Jwks.Unmarshal(&body.Jwks)...)
+	}
+
+	if !tfConfig.Metadata.IsNull() {
+		diags.Append(tfConfig.Metadata.Unmarshal(&body.Metadata)...)
+	}
+
+	if lifespans := tfConfig.Lifespans; lifespans != nil {
+		body.AuthorizationCodeGrantAccessTokenLifespan = nullableString(lifespans.AuthorizationCodeGrantAccessToken)
+		body.AuthorizationCodeGrantIdTokenLifespan = nullableString(lifespans.AuthorizationCodeGrantIDToken)
+		body.AuthorizationCodeGrantRefreshTokenLifespan = nullableString(lifespans.AuthorizationCodeGrantRefreshToken)
+		body.ClientCredentialsGrantAccessTokenLifespan = nullableString(lifespans.ClientCredentialsGrantAccessToken)
+		body.ImplicitGrantAccessTokenLifespan = nullableString(lifespans.ImplicitGrantAccessToken)
+		body.ImplicitGrantIdTokenLifespan = nullableString(lifespans.ImplicitGrantIDToken)
+		body.JwtBearerGrantAccessTokenLifespan = nullableString(lifespans.JwtBearerGrantAccessToken)
+		body.RefreshTokenGrantAccessTokenLifespan = nullableString(lifespans.RefreshTokenGrantAccessToken)
+		body.RefreshTokenGrantIdTokenLifespan = nullableString(lifespans.RefreshTokenGrantIDToken)
+		body.RefreshTokenGrantRefreshTokenLifespan = nullableString(lifespans.RefreshTokenGrantRefreshToken)
+	}
+
+	return body, diags
+}
+
+func knownString(value types.String) bool {
+	return !value.IsNull() && !value.IsUnknown()
+}
+
+// nullableString leaves unset lifespans out of the request so the project
+// defaults apply.
+func nullableString(value types.String) client.NullableString {
+	if !knownString(value) {
+		return client.NullableString{}
+	}
+
+	return *client.NewNullableString(value.ValueStringPointer())
+}
+
+func stringsToApi(values []types.String) []string {
+	if values == nil {
+		return nil
+	}
+
+	apiValues := make([]string, 0, len(values))
+	for _, value := range values {
+		apiValues = append(apiValues, value.ValueString())
+	}
+
+	return apiValues
+}
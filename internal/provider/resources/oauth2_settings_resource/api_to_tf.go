@@ -0,0 +1,83 @@
+package oauth2_settings_resource
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/helpers"
+	orytypes "github.com/kibblator/terraform-provider-ory/internal/provider/types"
+)
+
+// ApiToOAuth2Settings maps the live OAuth2 configuration onto the model, only
+// touching the settings the model manages.
+func ApiToOAuth2Settings(config orytypes.OAuth2Config, tfConfig *oauth2SettingsResourceModel) {
+	urls := orytypes.OAuth2URLs{}
+	if config.URLs != nil {
+		urls = *config.URLs
+	}
+
+	self := orytypes.OAuth2SelfURLs{}
+	if urls.Self != nil {
+		self = *urls.Self
+	}
+
+	ttl := orytypes.OAuth2TTL{}
+	if config.TTL != nil {
+		ttl = *config.TTL
+	}
+
+	strategies := orytypes.OAuth2Strategies{}
+	if config.Strategies != nil {
+		strategies = *config.Strategies
+	}
+
+	settings := orytypes.OAuth2Settings{}
+	if config.OAuth2 != nil {
+		settings = *config.OAuth2
+	}
+
+	refreshString(&tfConfig.IssuerURL, self.Issuer)
+	refreshString(&tfConfig.LoginURL, urls.Login)
+	refreshString(&tfConfig.ConsentURL, urls.Consent)
+	refreshString(&tfConfig.LogoutURL, urls.Logout)
+	refreshString(&tfConfig.ErrorURL, urls.Error)
+	refreshString(&tfConfig.PostLogoutRedirectURL, urls.PostLogoutRedirect)
+	refreshDuration(&tfConfig.AccessTokenLifespan, ttl.AccessToken)
+	refreshDuration(&tfConfig.RefreshTokenLifespan, ttl.RefreshToken)
+	refreshDuration(&tfConfig.IDTokenLifespan, ttl.IDToken)
+	refreshDuration(&tfConfig.AuthCodeLifespan, ttl.AuthCode)
+	refreshString(&tfConfig.AccessTokenStrategy, strategies.AccessToken)
+
+	if settings.TokenHook != nil {
+		refreshString(&tfConfig.TokenHookURL, settings.TokenHook.URL)
+	} else {
+		refreshString(&tfConfig.TokenHookURL, "")
+	}
+
+	if tfConfig.JWTBearerGrant != nil {
+		jwt := orytypes.OAuth2JWTGrant{}
+		if settings.Grant != nil && settings.Grant.JWT != nil {
+			jwt = *settings.Grant.JWT
+		}
+
+		if !tfConfig.JWTBearerGrant.JtiOptional.IsNull() {
+			tfConfig.JWTBearerGrant.JtiOptional = types.BoolValue(jwt.JtiOptional != nil && *jwt.JtiOptional)
+		}
+
+		if !tfConfig.JWTBearerGrant.IatOptional.IsNull() {
+			tfConfig.JWTBearerGrant.IatOptional = types.BoolValue(jwt.IatOptional != nil && *jwt.IatOptional)
+		}
+
+		refreshDuration(&tfConfig.JWTBearerGrant.MaxTTL, jwt.MaxTTL)
+	}
+}
+
+func refreshString(field *types.String, value string) {
+	if !field.IsNull() {
+		*field = helpers.StringOrNil(value)
+	}
+}
+
+func refreshDuration(field *types.String, value string) {
+	if !field.IsNull() {
+		*field = helpers.DurationOrState(value, *field)
+	}
+}
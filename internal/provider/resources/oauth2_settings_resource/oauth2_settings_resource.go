@@ -0,0 +1,287 @@
+package oauth2_settings_resource
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	oryclient "github.com/kibblator/terraform-provider-ory/internal/provider/clients"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/custom_validators"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &oauth2SettingsResource{}
+	_ resource.ResourceWithConfigure   = &oauth2SettingsResource{}
+	_ resource.ResourceWithImportState = &oauth2SettingsResource{}
+)
+
+// NewOAuth2SettingsResource is a helper function to simplify the provider implementation.
+func NewOAuth2SettingsResource() resource.Resource {
+	return &oauth2SettingsResource{}
+}
+
+// oauth2SettingsResource is the resource implementation.
+type oauth2SettingsResource struct {
+	oryClient *oryclient.OryClient
+}
+
+type JWTBearerGrant struct {
+	JtiOptional types.Bool   `tfsdk:"jti_optional"`
+	IatOptional types.Bool   `tfsdk:"iat_optional"`
+	MaxTTL      types.String `tfsdk:"max_ttl"`
+}
+
+// oauth2SettingsResourceModel maps the resource schema data.
+type oauth2SettingsResourceModel struct {
+	ID                    types.String    `tfsdk:"id"`
+	LastUpdated           types.String    `tfsdk:"last_updated"`
+	IssuerURL             types.String    `tfsdk:"issuer_url"`
+	LoginURL              types.String    `tfsdk:"login_url"`
+	ConsentURL            types.String    `tfsdk:"consent_url"`
+	LogoutURL             types.String    `tfsdk:"logout_url"`
+	ErrorURL              types.String    `tfsdk:"error_url"`
+	PostLogoutRedirectURL types.String    `tfsdk:"post_logout_redirect_url"`
+	AccessTokenLifespan   types.String    `tfsdk:"access_token_lifespan"`
+	RefreshTokenLifespan  types.String    `tfsdk:"refresh_token_lifespan"`
+	IDTokenLifespan       types.String    `tfsdk:"id_token_lifespan"`
+	AuthCodeLifespan      types.String    `tfsdk:"auth_code_lifespan"`
+	AccessTokenStrategy   types.String    `tfsdk:"access_token_strategy"`
+	TokenHookURL          types.String    `tfsdk:"token_hook_url"`
+	JWTBearerGrant        *JWTBearerGrant `tfsdk:"jwt_bearer_grant"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *oauth2SettingsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*oryclient.OryClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected OryClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.oryClient = client
+}
+
+// Metadata returns the resource type name.
+func (r *oauth2SettingsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_oauth2_settings"
+}
+
+func urlAttribute(description string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: description,
+		Optional:    true,
+		Validators: []validator.String{
+			custom_validators.URLValidator{Schemes: []string{"http", "https"}},
+		},
+	}
+}
+
+func durationAttribute(description string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: description,
+		Optional:    true,
+		Validators: []validator.String{
+			custom_validators.DurationValidator{},
+		},
+	}
+}
+
+// Schema defines the schema for the resource.
+func (r *oauth2SettingsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the OAuth2 server configuration of the project. Only the configured settings are managed.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "String identifier of the OAuth2 settings resource.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update of the OAuth2 settings.",
+				Computed:    true,
+			},
+			"issuer_url":               urlAttribute("Issuer URL of the OAuth2 server, used in the `iss` claim of issued tokens."),
+			"login_url":                urlAttribute("URL of the login UI."),
+			"consent_url":              urlAttribute("URL of the consent UI."),
+			"logout_url":               urlAttribute("URL of the logout UI."),
+			"error_url":                urlAttribute("URL of the error UI."),
+			"post_logout_redirect_url": urlAttribute("URL users are redirected to after logout when the client doesn't provide one."),
+			"access_token_lifespan":    durationAttribute("How long access tokens are valid for, as a duration such as `1h`."),
+			"refresh_token_lifespan":   durationAttribute("How long refresh tokens are valid for, as a duration such as `720h`."),
+			"id_token_lifespan":        durationAttribute("How long ID tokens are valid for, as a duration such as `1h`."),
+			"auth_code_lifespan":       durationAttribute("How long authorization codes are valid for, as a duration such as `10m`."),
+			"access_token_strategy": schema.StringAttribute{
+				Description: "Format of issued access tokens, `jwt` or `opaque`.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("jwt", "opaque"),
+				},
+			},
+			"token_hook_url": urlAttribute("URL of the webhook called before tokens are issued, allowing to customize their claims."),
+			"jwt_bearer_grant": schema.SingleNestedAttribute{
+				Description: "Settings of the JWT bearer grant (RFC 7523). Only the configured fields are managed.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"jti_optional": schema.BoolAttribute{
+						Description: "If enabled, assertions don't need a `jti` claim.",
+						Optional:    true,
+					},
+					"iat_optional": schema.BoolAttribute{
+						Description: "If enabled, assertions don't need an `iat` claim.",
+						Optional:    true,
+					},
+					"max_ttl": durationAttribute("Maximum lifetime of accepted assertions, as a duration such as `720h`."),
+				},
+			},
+		},
+	}
+}
+
+// Create a new resource.
+func (r *oauth2SettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan oauth2SettingsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.apply(&plan)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating ory OAuth2 settings",
+			"Could not create ory OAuth2 settings, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.StringValue("oauth2_settings")
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *oauth2SettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading OAuth2 settings resource")
+
+	// Retrieve current state
+	var state oauth2SettingsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Fetch current project configuration from ORY
+	project, err := r.oryClient.APIClient.GetProject(&r.oryClient.Mutex)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error fetching ORY OAuth2 settings",
+			"Could not retrieve ORY OAuth2 settings: "+err.Error(),
+		)
+		return
+	}
+
+	ApiToOAuth2Settings(project.Services.OAuth2.Config, &state)
+
+	// Set the updated state
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *oauth2SettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve the desired state from the plan
+	var plan oauth2SettingsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.apply(&plan)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating ory OAuth2 settings",
+			"Could not update ory OAuth2 settings, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set the updated plan to the state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *oauth2SettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+func (r *oauth2SettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// apply patches the planned OAuth2 settings and maps the result onto the plan.
+func (r *oauth2SettingsResource) apply(plan *oauth2SettingsResourceModel) error {
+	project, err := r.oryClient.APIClient.GetProject(&r.oryClient.Mutex)
+	if err != nil {
+		return err
+	}
+
+	patch := OAuth2SettingsToApi(*plan, project.Services.OAuth2.Config)
+
+	if len(patch) == 0 {
+		return nil
+	}
+
+	projectUpdate, err := r.oryClient.APIClient.PatchProject(project.RevisionId, patch, &r.oryClient.Mutex)
+	if err != nil {
+		return err
+	}
+
+	ApiToOAuth2Settings(projectUpdate.Project.Services.OAuth2.Config, plan)
+
+	return nil
+}
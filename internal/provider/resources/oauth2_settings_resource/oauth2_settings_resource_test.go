@@ -0,0 +1,74 @@
+package oauth2_settings_resource_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/kibblator/terraform-provider-ory/internal/provider/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOryOAuth2SettingsResource(t *testing.T) {
+	randomName := acctest.GenerateRandomResourceName()
+	resourceName := fmt.Sprintf("ory_oauth2_settings.%s", randomName)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			// Invalid strategies are rejected at plan time
+			{
+				Config: fmt.Sprintf(`
+resource "ory_oauth2_settings" "%s" {
+  access_token_strategy = "random"
+}
+`, randomName),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+			// Create and Read testing
+			{
+				Config: fmt.Sprintf(`
+resource "ory_oauth2_settings" "%s" {
+  login_url             = "https://app.example.com/login"
+  consent_url           = "https://app.example.com/consent"
+  access_token_lifespan = "1h"
+  access_token_strategy = "opaque"
+}
+`, randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "oauth2_settings"),
+					resource.TestCheckResourceAttr(resourceName, "login_url", "https://app.example.com/login"),
+					resource.TestCheckResourceAttr(resourceName, "consent_url", "https://app.example.com/consent"),
+					resource.TestCheckResourceAttr(resourceName, "access_token_lifespan", "1h"),
+					resource.TestCheckResourceAttr(resourceName, "access_token_strategy", "opaque"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_updated"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: fmt.Sprintf(`
+resource "ory_oauth2_settings" "%s" {
+  login_url             = "https://app.example.com/sign-in"
+  consent_url           = "https://app.example.com/consent"
+  access_token_lifespan = "30m"
+  access_token_strategy = "jwt"
+
+  jwt_bearer_grant = {
+    jti_optional = true
+    max_ttl      = "24h"
+  }
+}
+`, randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "login_url", "https://app.example.com/sign-in"),
+					resource.TestCheckResourceAttr(resourceName, "access_token_lifespan", "30m"),
+					resource.TestCheckResourceAttr(resourceName, "access_token_strategy", "jwt"),
+					resource.TestCheckResourceAttr(resourceName, "jwt_bearer_grant.jti_optional", "true"),
+					resource.TestCheckResourceAttr(resourceName, "jwt_bearer_grant.max_ttl", "24h"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,106 @@
+package oauth2_settings_resource
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	orytypes "github.com/kibblator/terraform-provider-ory/internal/provider/types"
+	"github.com/ory/client-go"
+)
+
+const configPath = "/services/oauth2/config"
+
+// OAuth2SettingsToApi returns the patch applying the configured OAuth2 settings.
+// Each setting is written on its own, creating the objects missing from the
+// live configuration on the way so unmanaged settings are left untouched.
+func OAuth2SettingsToApi(plan oauth2SettingsResourceModel, config orytypes.OAuth2Config) []client.JsonPatch {
+	builder := newPatchBuilder(config)
+
+	builder.addString("/urls/self/issuer", plan.IssuerURL)
+	builder.addString("/urls/login", plan.LoginURL)
+	builder.addString("/urls/consent", plan.ConsentURL)
+	builder.addString("/urls/logout", plan.LogoutURL)
+	builder.addString("/urls/error", plan.ErrorURL)
+	builder.addString("/urls/post_logout_redirect", plan.PostLogoutRedirectURL)
+	builder.addString("/ttl/access_token", plan.AccessTokenLifespan)
+	builder.addString("/ttl/refresh_token", plan.RefreshTokenLifespan)
+	builder.addString("/ttl/id_token", plan.IDTokenLifespan)
+	builder.addString("/ttl/auth_code", plan.AuthCodeLifespan)
+	builder.addString("/strategies/access_token", plan.AccessTokenStrategy)
+	builder.addString("/oauth2/token_hook", plan.TokenHookURL)
+
+	if grant := plan.JWTBearerGrant; grant != nil {
+		if !grant.JtiOptional.IsNull() {
+			builder.add("/oauth2/grant/jwt/jti_optional", grant.JtiOptional.ValueBool())
+		}
+
+		if !grant.IatOptional.IsNull() {
+			builder.add("/oauth2/grant/jwt/iat_optional", grant.IatOptional.ValueBool())
+		}
+
+		builder.addString("/oauth2/grant/jwt/max_ttl", grant.MaxTTL)
+	}
+
+	return builder.patch
+}
+
+type patchBuilder struct {
+	patch    []client.JsonPatch
+	existing map[string]bool
+}
+
+func newPatchBuilder(config orytypes.OAuth2Config) *patchBuilder {
+	existing := map[string]bool{}
+
+	if config.URLs != nil {
+		existing["/urls"] = true
+		existing["/urls/self"] = config.URLs.Self != nil
+	}
+
+	existing["/ttl"] = config.TTL != nil
+	existing["/strategies"] = config.Strategies != nil
+
+	if config.OAuth2 != nil {
+		existing["/oauth2"] = true
+
+		if config.OAuth2.Grant != nil {
+			existing["/oauth2/grant"] = true
+			existing["/oauth2/grant/jwt"] = config.OAuth2.Grant.JWT != nil
+		}
+	}
+
+	return &patchBuilder{existing: existing}
+}
+
+func (b *patchBuilder) addString(pointer string, value types.String) {
+	if value.IsNull() || value.IsUnknown() {
+		return
+	}
+
+	b.add(pointer, value.ValueString())
+}
+
+// add sets the value at the pointer relative to the OAuth2 configuration,
+// adding empty objects for missing parents first.
+func (b *patchBuilder) add(pointer string, value interface{}) {
+	tokens := strings.Split(pointer, "/")[1:]
+
+	for i := 1; i < len(tokens); i++ {
+		parent := "/" + strings.Join(tokens[:i], "/")
+
+		if !b.existing[parent] {
+			b.patch = append(b.patch, client.JsonPatch{
+				Op:    "add",
+				Path:  configPath + parent,
+				Value: map[string]interface{}{},
+			})
+			b.existing[parent] = true
+		}
+	}
+
+	b.patch = append(b.patch, client.JsonPatch{
+		Op:    "add",
+		Path:  configPath + pointer,
+		Value: value,
+	})
+}
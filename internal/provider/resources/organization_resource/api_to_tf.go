@@ -0,0 +1,20 @@
+package organization_resource
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ory/client-go"
+)
+
+// ApiToOrganization maps the organization returned by the API onto the model.
+func ApiToOrganization(organization *client.Organization, tfConfig *organizationResourceModel) {
+	tfConfig.ID = types.StringValue(organization.Id)
+	tfConfig.Label = types.StringValue(organization.Label)
+
+	if len(organization.Domains) > 0 || tfConfig.Domains != nil {
+		domains := []types.String{}
+		for _, domain := range organization.Domains {
+			domains = append(domains, types.StringValue(domain))
+		}
+		tfConfig.Domains = domains
+	}
+}
@@ -0,0 +1,233 @@
+package organization_resource
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	oryclient "github.com/kibblator/terraform-provider-ory/internal/provider/clients"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &organizationResource{}
+	_ resource.ResourceWithConfigure   = &organizationResource{}
+	_ resource.ResourceWithImportState = &organizationResource{}
+)
+
+// NewOrganizationResource is a helper function to simplify the provider implementation.
+func NewOrganizationResource() resource.Resource {
+	return &organizationResource{}
+}
+
+// organizationResource is the resource implementation.
+type organizationResource struct {
+	oryClient *oryclient.OryClient
+}
+
+// organizationResourceModel maps the resource schema data.
+type organizationResourceModel struct {
+	ID          types.String   `tfsdk:"id"`
+	LastUpdated types.String   `tfsdk:"last_updated"`
+	Label       types.String   `tfsdk:"label"`
+	Domains     []types.String `tfsdk:"domains"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *organizationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*oryclient.OryClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected OryClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.oryClient = client
+}
+
+// Metadata returns the resource type name.
+func (r *organizationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization"
+}
+
+// Schema defines the schema for the resource.
+func (r *organizationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a B2B SSO organization of the project. Users signing in with an email address of one of its domains are sent to the SSO providers attached with `ory_organization_sso_provider`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the organization.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update of the organization.",
+				Computed:    true,
+			},
+			"label": schema.StringAttribute{
+				Description: "Human readable name of the organization.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"domains": schema.SetAttribute{
+				Description: "Email domains of the organization, for example `example.com`.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(domainPattern, "must be a domain name such as `example.com`"),
+					),
+				},
+			},
+		},
+	}
+}
+
+// Create a new resource.
+func (r *organizationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan organizationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organization, _, err := r.oryClient.ConsoleAPIClient.ProjectAPI.CreateOrganization(ctx, r.oryClient.ProjectID).OrganizationBody(OrganizationToApi(plan)).Execute()
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating ory organization",
+			"Could not create ory organization, unexpected error: "+oryclient.ErrorDetail(err),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	ApiToOrganization(organization, &plan)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *organizationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading organization resource")
+
+	// Retrieve current state
+	var state organizationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organization, httpResp, err := r.oryClient.ConsoleAPIClient.ProjectAPI.GetOrganization(ctx, r.oryClient.ProjectID, state.ID.ValueString()).Execute()
+
+	if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error fetching ORY organization",
+			"Could not retrieve ORY organization: "+oryclient.ErrorDetail(err),
+		)
+		return
+	}
+
+	ApiToOrganization(&organization.Organization, &state)
+
+	// Set the updated state
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *organizationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve the desired state from the plan
+	var plan organizationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organization, _, err := r.oryClient.ConsoleAPIClient.ProjectAPI.UpdateOrganization(ctx, r.oryClient.ProjectID, plan.ID.ValueString()).OrganizationBody(OrganizationToApi(plan)).Execute()
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating ory organization",
+			"Could not update ory organization, unexpected error: "+oryclient.ErrorDetail(err),
+		)
+		return
+	}
+
+	ApiToOrganization(organization, &plan)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set the updated plan to the state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *organizationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state organizationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.oryClient.ConsoleAPIClient.ProjectAPI.DeleteOrganization(ctx, r.oryClient.ProjectID, state.ID.ValueString()).Execute()
+
+	if err != nil && (httpResp == nil || httpResp.StatusCode != http.StatusNotFound) {
+		resp.Diagnostics.AddError(
+			"Error deleting ory organization",
+			"Could not delete ory organization, unexpected error: "+oryclient.ErrorDetail(err),
+		)
+		return
+	}
+}
+
+func (r *organizationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,22 @@
+package organization_resource
+
+import (
+	"regexp"
+
+	"github.com/ory/client-go"
+)
+
+var domainPattern = regexp.MustCompile(`^([a-z0-9]([a-z0-9-]*[a-z0-9])?\.)+[a-z]{2,}$`)
+
+// OrganizationToApi maps the model onto the organization request body.
+func OrganizationToApi(tfConfig organizationResourceModel) client.OrganizationBody {
+	domains := []string{}
+	for _, domain := range tfConfig.Domains {
+		domains = append(domains, domain.ValueString())
+	}
+
+	return client.OrganizationBody{
+		Label:   tfConfig.Label.ValueStringPointer(),
+		Domains: domains,
+	}
+}
@@ -0,0 +1,38 @@
+package organization_sso_provider_resource
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/helpers"
+	orytypes "github.com/kibblator/terraform-provider-ory/internal/provider/types"
+)
+
+// ApiToSSOProvider maps the provider configuration onto the model. The client
+// secret is kept as is when the API doesn't return it.
+func ApiToSSOProvider(provider orytypes.Provider, tfConfig *organizationSSOProviderResourceModel) {
+	tfConfig.ID = types.StringValue(tfConfig.Method.ValueString() + ":" + provider.ID)
+	tfConfig.ProviderID = types.StringValue(provider.ID)
+	tfConfig.Label = helpers.StringOrNil(provider.Label)
+	tfConfig.ClientID = helpers.StringOrNil(provider.ClientID)
+	tfConfig.IssuerURL = helpers.StringOrNil(provider.IssuerURL)
+	tfConfig.AuthURL = helpers.StringOrNil(provider.AuthURL)
+	tfConfig.TokenURL = helpers.StringOrNil(provider.TokenURL)
+	tfConfig.MapperURL = helpers.StringOrNil(provider.MapperURL)
+	tfConfig.OrganizationID = helpers.StringOrNil(provider.OrganizationID)
+	tfConfig.IDPMetadataURL = helpers.StringOrNil(provider.IDPMetadataURL)
+
+	if provider.Provider != "" {
+		tfConfig.ProviderType = types.StringValue(provider.Provider)
+	}
+
+	if provider.ClientSecret != "" {
+		tfConfig.ClientSecret = types.StringValue(provider.ClientSecret)
+	}
+
+	if len(provider.Scope) > 0 || tfConfig.Scope != nil {
+		scope := []types.String{}
+		for _, value := range provider.Scope {
+			scope = append(scope, types.StringValue(value))
+		}
+		tfConfig.Scope = scope
+	}
+}
@@ -0,0 +1,418 @@
+package organization_sso_provider_resource
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	oryclient "github.com/kibblator/terraform-provider-ory/internal/provider/clients"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/custom_validators"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/helpers"
+	orytypes "github.com/kibblator/terraform-provider-ory/internal/provider/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &organizationSSOProviderResource{}
+	_ resource.ResourceWithConfigure      = &organizationSSOProviderResource{}
+	_ resource.ResourceWithImportState    = &organizationSSOProviderResource{}
+	_ resource.ResourceWithValidateConfig = &organizationSSOProviderResource{}
+)
+
+// NewOrganizationSSOProviderResource is a helper function to simplify the provider implementation.
+func NewOrganizationSSOProviderResource() resource.Resource {
+	return &organizationSSOProviderResource{}
+}
+
+// organizationSSOProviderResource is the resource implementation.
+type organizationSSOProviderResource struct {
+	oryClient *oryclient.OryClient
+}
+
+// organizationSSOProviderResourceModel maps the resource schema data.
+type organizationSSOProviderResourceModel struct {
+	ID             types.String   `tfsdk:"id"`
+	LastUpdated    types.String   `tfsdk:"last_updated"`
+	OrganizationID types.String   `tfsdk:"organization_id"`
+	Method         types.String   `tfsdk:"method"`
+	ProviderID     types.String   `tfsdk:"provider_id"`
+	ProviderType   types.String   `tfsdk:"provider_type"`
+	Label          types.String   `tfsdk:"label"`
+	ClientID       types.String   `tfsdk:"client_id"`
+	ClientSecret   types.String   `tfsdk:"client_secret"`
+	IssuerURL      types.String   `tfsdk:"issuer_url"`
+	AuthURL        types.String   `tfsdk:"auth_url"`
+	TokenURL       types.String   `tfsdk:"token_url"`
+	Scope          []types.String `tfsdk:"scope"`
+	MapperURL      types.String   `tfsdk:"mapper_url"`
+	IDPMetadataURL types.String   `tfsdk:"idp_metadata_url"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *organizationSSOProviderResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*oryclient.OryClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected OryClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.oryClient = client
+}
+
+// Metadata returns the resource type name.
+func (r *organizationSSOProviderResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_sso_provider"
+}
+
+// Schema defines the schema for the resource.
+func (r *organizationSSOProviderResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Attaches an OIDC or SAML identity provider to an organization. The provider is added to the providers of the sign-in method, which is enabled when needed, and only offered to members of the organization. SAML providers with inline metadata are managed with `ory_saml_provider`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "String identifier of the SSO provider, in the format `<method>:<provider_id>`.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update of the SSO provider.",
+				Computed:    true,
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "ID of the organization the provider is attached to.",
+				Required:    true,
+			},
+			"method": schema.StringAttribute{
+				Description: "Sign-in method of the provider, either `oidc` or `saml`. Defaults to `oidc`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(helpers.MethodOIDC),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(helpers.MethodOIDC, helpers.MethodSAML),
+				},
+			},
+			"provider_id": schema.StringAttribute{
+				Description: "Unique ID of the provider, used in the callback URL. Changing it recreates the provider.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(providerIDPattern, "must only contain lowercase letters, digits, `-` and `_`"),
+				},
+			},
+			"provider_type": schema.StringAttribute{
+				Description: "Type of the provider, for example `generic`, `microsoft` or `google`. Defaults to `generic`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("generic"),
+			},
+			"label": schema.StringAttribute{
+				Description: "Label of the provider shown on the sign-in button.",
+				Optional:    true,
+			},
+			"client_id": schema.StringAttribute{
+				Description: "OAuth2 client ID issued by the identity provider. Required for `oidc` providers.",
+				Optional:    true,
+			},
+			"client_secret": schema.StringAttribute{
+				Description: "OAuth2 client secret issued by the identity provider. Required for `oidc` providers.",
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"issuer_url": schema.StringAttribute{
+				Description: "OpenID Connect issuer URL of the identity provider, used for discovery.",
+				Optional:    true,
+				Validators: []validator.String{
+					custom_validators.URLValidator{Schemes: []string{"https"}},
+				},
+			},
+			"auth_url": schema.StringAttribute{
+				Description: "Authorization endpoint, for providers without discovery.",
+				Optional:    true,
+				Validators: []validator.String{
+					custom_validators.URLValidator{Schemes: []string{"https"}},
+				},
+			},
+			"token_url": schema.StringAttribute{
+				Description: "Token endpoint, for providers without discovery.",
+				Optional:    true,
+				Validators: []validator.String{
+					custom_validators.URLValidator{Schemes: []string{"https"}},
+				},
+			},
+			"scope": schema.ListAttribute{
+				Description: "OAuth2 scopes requested from the identity provider, for example `openid` and `email`.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+				},
+			},
+			"mapper_url": schema.StringAttribute{
+				Description: "URL of the Jsonnet mapping the claims or attributes of the identity provider to identity traits, for example `base64://...` or `https://...`.",
+				Required:    true,
+				Validators: []validator.String{
+					custom_validators.URLValidator{Schemes: []string{"http", "https", "file", "base64"}},
+				},
+			},
+			"idp_metadata_url": schema.StringAttribute{
+				Description: "URL of the SAML metadata of the identity provider. Required for `saml` providers.",
+				Optional:    true,
+				Validators: []validator.String{
+					custom_validators.URLValidator{Schemes: []string{"http", "https"}},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that the configured attributes match the method.
+func (r *organizationSSOProviderResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config organizationSSOProviderResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Method.IsUnknown() {
+		return
+	}
+
+	method := config.Method.ValueString()
+	if config.Method.IsNull() {
+		method = helpers.MethodOIDC
+	}
+
+	required := map[string]types.String{}
+	unsupported := map[string]bool{}
+
+	switch method {
+	case helpers.MethodOIDC:
+		required["client_id"] = config.ClientID
+		required["client_secret"] = config.ClientSecret
+		unsupported["idp_metadata_url"] = !config.IDPMetadataURL.IsNull()
+	case helpers.MethodSAML:
+		required["idp_metadata_url"] = config.IDPMetadataURL
+		unsupported["client_id"] = !config.ClientID.IsNull()
+		unsupported["client_secret"] = !config.ClientSecret.IsNull()
+		unsupported["issuer_url"] = !config.IssuerURL.IsNull()
+		unsupported["auth_url"] = !config.AuthURL.IsNull()
+		unsupported["token_url"] = !config.TokenURL.IsNull()
+		unsupported["scope"] = config.Scope != nil
+	}
+
+	for attribute, value := range required {
+		if value.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attribute),
+				"Missing required attribute",
+				fmt.Sprintf("The attribute %q is required for %s providers.", attribute, method),
+			)
+		}
+	}
+
+	for attribute, set := range unsupported {
+		if set {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attribute),
+				"Unsupported attribute",
+				fmt.Sprintf("The attribute %q is not supported by %s providers.", attribute, method),
+			)
+		}
+	}
+}
+
+// Create a new resource.
+func (r *organizationSSOProviderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan organizationSSOProviderResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	provider := SSOProviderToApi(plan)
+	err := r.apply(plan.Method.ValueString(), plan.ProviderID.ValueString(), &provider)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating ory organization SSO provider",
+			"Could not create ory organization SSO provider, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.StringValue(plan.Method.ValueString() + ":" + plan.ProviderID.ValueString())
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *organizationSSOProviderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading organization SSO provider resource")
+
+	// Retrieve current state
+	var state organizationSSOProviderResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Fetch current project configuration from ORY
+	project, err := r.oryClient.APIClient.GetProject(&r.oryClient.Mutex)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error fetching ORY organization SSO provider",
+			"Could not retrieve ORY organization SSO provider: "+err.Error(),
+		)
+		return
+	}
+
+	live := helpers.LiveProviderMethod(project, state.Method.ValueString())
+	index := helpers.FindProvider(live, state.ProviderID.ValueString())
+
+	if index < 0 {
+		tflog.Debug(ctx, "SSO provider no longer exists, removing from state", map[string]interface{}{
+			"id": state.ID.ValueString(),
+		})
+
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	ApiToSSOProvider(live.Config.Providers[index], &state)
+
+	// Set the updated state
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *organizationSSOProviderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve the desired state from the plan
+	var plan organizationSSOProviderResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	provider := SSOProviderToApi(plan)
+	err := r.apply(plan.Method.ValueString(), plan.ProviderID.ValueString(), &provider)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating ory organization SSO provider",
+			"Could not update ory organization SSO provider, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set the updated plan to the state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete removes the provider from the sign-in method, leaving the method
+// enabled.
+func (r *organizationSSOProviderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state organizationSSOProviderResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.apply(state.Method.ValueString(), state.ProviderID.ValueString(), nil)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting ory organization SSO provider",
+			"Could not delete ory organization SSO provider, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports a provider by its `<method>:<provider_id>` identifier.
+func (r *organizationSSOProviderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	method, providerID, found := strings.Cut(req.ID, ":")
+
+	if !found || (method != helpers.MethodOIDC && method != helpers.MethodSAML) || providerID == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected an import ID in the format `<method>:<provider_id>` with the method `oidc` or `saml`, got %q.", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("method"), method)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("provider_id"), providerID)...)
+}
+
+// apply adds, replaces or, when provider is nil, removes the provider in the
+// live configuration of the method.
+func (r *organizationSSOProviderResource) apply(method, providerID string, provider *orytypes.Provider) error {
+	project, err := r.oryClient.APIClient.GetProject(&r.oryClient.Mutex)
+	if err != nil {
+		return err
+	}
+
+	patch := helpers.ProviderPatch(method, helpers.LiveProviderMethod(project, method), providerID, provider)
+
+	if len(patch) == 0 {
+		return nil
+	}
+
+	_, err = r.oryClient.APIClient.PatchProject(project.RevisionId, patch, &r.oryClient.Mutex)
+
+	return err
+}
@@ -0,0 +1,87 @@
+package organization_sso_provider_resource_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/kibblator/terraform-provider-ory/internal/provider/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOryOrganizationSSOProviderResource(t *testing.T) {
+	randomName := acctest.GenerateRandomResourceName()
+	resourceName := fmt.Sprintf("ory_organization_sso_provider.%s", randomName)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			// Attributes of the other method are rejected
+			{
+				Config: testAccOryOrganizationSSOProvider(randomName, `
+  method           = "saml"
+  client_id        = "client"
+  idp_metadata_url = "https://idp.example.com/metadata.xml"
+`),
+				ExpectError: regexp.MustCompile("Unsupported attribute"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccOryOrganizationSSOProvider(randomName, `
+  label         = "Example SSO"
+  client_id     = "client"
+  client_secret = "secret"
+  issuer_url    = "https://idp.example.com"
+  scope         = ["openid", "email"]
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "oidc:"+randomName),
+					resource.TestCheckResourceAttr(resourceName, "provider_type", "generic"),
+					resource.TestCheckResourceAttrPair(resourceName, "organization_id", fmt.Sprintf("ory_organization.%s", randomName), "id"),
+					resource.TestCheckResourceAttr(resourceName, "scope.#", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_updated"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"last_updated",  // If 'last_updated' isn't returned from the ORY API, ignore it during import verification
+					"client_secret", // The secret may be redacted by the API
+				},
+			},
+			// Update and Read testing
+			{
+				Config: testAccOryOrganizationSSOProvider(randomName, `
+  label         = "Example SSO (updated)"
+  client_id     = "client"
+  client_secret = "secret"
+  issuer_url    = "https://idp.example.com"
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "label", "Example SSO (updated)"),
+					resource.TestCheckNoResourceAttr(resourceName, "scope"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOryOrganizationSSOProvider(randomName string, attributes string) string {
+	return fmt.Sprintf(`
+resource "ory_organization" "%[1]s" {
+  label   = "%[1]s"
+  domains = ["%[1]s.example.com"]
+}
+
+resource "ory_organization_sso_provider" "%[1]s" {
+  organization_id = ory_organization.%[1]s.id
+  provider_id     = "%[1]s"
+  mapper_url      = "base64://bG9jYWwgY2xhaW1zID0gc3RkLmV4dFZhcignY2xhaW1zJyk7IHsgaWRlbnRpdHk6IHsgdHJhaXRzOiB7IGVtYWlsOiBjbGFpbXMuZW1haWwgfSB9IH0="
+%[2]s}
+`, randomName, attributes)
+}
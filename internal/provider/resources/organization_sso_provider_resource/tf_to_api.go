@@ -0,0 +1,32 @@
+package organization_sso_provider_resource
+
+import (
+	"regexp"
+
+	orytypes "github.com/kibblator/terraform-provider-ory/internal/provider/types"
+)
+
+var providerIDPattern = regexp.MustCompile(`^[a-z0-9_-]+$`)
+
+// SSOProviderToApi maps the model onto the provider configuration.
+func SSOProviderToApi(tfConfig organizationSSOProviderResourceModel) orytypes.Provider {
+	provider := orytypes.Provider{
+		ID:             tfConfig.ProviderID.ValueString(),
+		Provider:       tfConfig.ProviderType.ValueString(),
+		Label:          tfConfig.Label.ValueString(),
+		ClientID:       tfConfig.ClientID.ValueString(),
+		ClientSecret:   tfConfig.ClientSecret.ValueString(),
+		IssuerURL:      tfConfig.IssuerURL.ValueString(),
+		AuthURL:        tfConfig.AuthURL.ValueString(),
+		TokenURL:       tfConfig.TokenURL.ValueString(),
+		MapperURL:      tfConfig.MapperURL.ValueString(),
+		OrganizationID: tfConfig.OrganizationID.ValueString(),
+		IDPMetadataURL: tfConfig.IDPMetadataURL.ValueString(),
+	}
+
+	for _, scope := range tfConfig.Scope {
+		provider.Scope = append(provider.Scope, scope.ValueString())
+	}
+
+	return provider
+}
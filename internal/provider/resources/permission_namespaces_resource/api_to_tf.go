@@ -0,0 +1,36 @@
+package permission_namespaces_resource
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/helpers"
+	orytypes "github.com/kibblator/terraform-provider-ory/internal/provider/types"
+)
+
+// ApiToPermissionNamespaces maps the live namespaces onto the model. Sources
+// not uploaded inline, such as remote files or the legacy namespace list,
+// can't be compared and are reported as missing.
+func ApiToPermissionNamespaces(namespaces *orytypes.PermissionNamespaces, tfConfig *permissionNamespacesResourceModel) {
+	tfConfig.OPL = types.StringNull()
+
+	if namespaces != nil {
+		if source, ok := helpers.DecodeBase64URL(namespaces.Location); ok {
+			tfConfig.OPL = types.StringValue(source)
+		}
+	}
+
+	tfConfig.Namespaces = namespacesToTf(tfConfig.OPL)
+}
+
+func namespacesToTf(opl types.String) []types.String {
+	names, err := helpers.CheckOPL(opl.ValueString())
+	if err != nil {
+		return []types.String{}
+	}
+
+	namespaces := make([]types.String, 0, len(names))
+	for _, name := range names {
+		namespaces = append(namespaces, types.StringValue(name))
+	}
+
+	return namespaces
+}
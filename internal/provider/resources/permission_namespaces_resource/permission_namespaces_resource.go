@@ -0,0 +1,231 @@
+package permission_namespaces_resource
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	oryclient "github.com/kibblator/terraform-provider-ory/internal/provider/clients"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/custom_validators"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &permissionNamespacesResource{}
+	_ resource.ResourceWithConfigure   = &permissionNamespacesResource{}
+	_ resource.ResourceWithImportState = &permissionNamespacesResource{}
+)
+
+// NewPermissionNamespacesResource is a helper function to simplify the provider implementation.
+func NewPermissionNamespacesResource() resource.Resource {
+	return &permissionNamespacesResource{}
+}
+
+// permissionNamespacesResource is the resource implementation.
+type permissionNamespacesResource struct {
+	oryClient *oryclient.OryClient
+}
+
+// permissionNamespacesResourceModel maps the resource schema data.
+type permissionNamespacesResourceModel struct {
+	ID          types.String   `tfsdk:"id"`
+	LastUpdated types.String   `tfsdk:"last_updated"`
+	OPL         types.String   `tfsdk:"opl"`
+	Namespaces  []types.String `tfsdk:"namespaces"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *permissionNamespacesResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*oryclient.OryClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected OryClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.oryClient = client
+}
+
+// Metadata returns the resource type name.
+func (r *permissionNamespacesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_permission_namespaces"
+}
+
+// Schema defines the schema for the resource.
+func (r *permissionNamespacesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the Ory Permissions namespaces of the project, defined in the Ory Permission Language (OPL).",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "String identifier of the permission namespaces resource.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update of the permission namespaces.",
+				Computed:    true,
+			},
+			"opl": schema.StringAttribute{
+				Description: "Ory Permission Language source declaring the namespaces, usually read with `file()`. It is checked for syntax and structure at plan time and replaces the current namespaces.",
+				Required:    true,
+				Validators: []validator.String{
+					custom_validators.OPLValidator{},
+				},
+			},
+			"namespaces": schema.ListAttribute{
+				Description: "Names of the namespaces declared in the source.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Create a new resource.
+func (r *permissionNamespacesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan permissionNamespacesResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	patch := PermissionNamespacesToApi(plan)
+
+	project, err := r.oryClient.APIClient.GetProject(&r.oryClient.Mutex)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error fetching ORY permission namespaces",
+			"Could not retrieve ORY permission namespaces: "+err.Error(),
+		)
+		return
+	}
+
+	_, err = r.oryClient.APIClient.PatchProject(project.RevisionId, patch, &r.oryClient.Mutex)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating ory permission namespaces",
+			"Could not create ory permission namespaces, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.StringValue("permission_namespaces")
+	plan.Namespaces = namespacesToTf(plan.OPL)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *permissionNamespacesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading permission namespaces resource")
+
+	// Retrieve current state
+	var state permissionNamespacesResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Fetch current project configuration from ORY
+	project, err := r.oryClient.APIClient.GetProject(&r.oryClient.Mutex)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error fetching ORY permission namespaces",
+			"Could not retrieve ORY permission namespaces: "+err.Error(),
+		)
+		return
+	}
+
+	ApiToPermissionNamespaces(project.Services.Permission.Config.Namespaces, &state)
+
+	// Set the updated state
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *permissionNamespacesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve the desired state from the plan
+	var plan permissionNamespacesResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	patch := PermissionNamespacesToApi(plan)
+
+	project, err := r.oryClient.APIClient.GetProject(&r.oryClient.Mutex)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error fetching ORY permission namespaces",
+			"Could not retrieve ORY permission namespaces: "+err.Error(),
+		)
+		return
+	}
+
+	_, err = r.oryClient.APIClient.PatchProject(project.RevisionId, patch, &r.oryClient.Mutex)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating ory permission namespaces",
+			"Could not update ory permission namespaces, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.Namespaces = namespacesToTf(plan.OPL)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set the updated plan to the state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *permissionNamespacesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+func (r *permissionNamespacesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
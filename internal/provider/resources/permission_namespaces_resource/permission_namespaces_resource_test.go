@@ -0,0 +1,85 @@
+package permission_namespaces_resource_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/kibblator/terraform-provider-ory/internal/provider/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOryPermissionNamespacesResource(t *testing.T) {
+	randomName := acctest.GenerateRandomResourceName()
+	resourceName := fmt.Sprintf("ory_permission_namespaces.%s", randomName)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			// Invalid sources are rejected at plan time
+			{
+				Config: fmt.Sprintf(`
+resource "ory_permission_namespaces" "%s" {
+  opl = "class User implements Namespace {"
+}
+`, randomName),
+				ExpectError: regexp.MustCompile("Invalid Ory Permission Language"),
+			},
+			// Create and Read testing
+			{
+				Config: fmt.Sprintf(`
+resource "ory_permission_namespaces" "%s" {
+  opl = <<-EOT
+    import { Namespace } from "@ory/keto-namespace-types"
+
+    class User implements Namespace {}
+  EOT
+}
+`, randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "permission_namespaces"),
+					resource.TestCheckResourceAttr(resourceName, "namespaces.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "namespaces.0", "User"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_updated"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"last_updated", // If 'last_updated' isn't returned from the ORY API, ignore it during import verification
+				},
+			},
+			// Update and Read testing
+			{
+				Config: fmt.Sprintf(`
+resource "ory_permission_namespaces" "%s" {
+  opl = <<-EOT
+    import { Namespace, Context } from "@ory/keto-namespace-types"
+
+    class User implements Namespace {}
+
+    class Document implements Namespace {
+      related: {
+        viewers: User[]
+      }
+
+      permits = {
+        view: (ctx: Context): boolean => this.related.viewers.includes(ctx.subject),
+      }
+    }
+  EOT
+}
+`, randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "namespaces.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "namespaces.1", "Document"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,21 @@
+package permission_namespaces_resource
+
+import (
+	"github.com/kibblator/terraform-provider-ory/internal/provider/helpers"
+	orytypes "github.com/kibblator/terraform-provider-ory/internal/provider/types"
+	"github.com/ory/client-go"
+)
+
+// PermissionNamespacesToApi returns the patch uploading the Ory Permission
+// Language source inline as a base64:// URL.
+func PermissionNamespacesToApi(plan permissionNamespacesResourceModel) []client.JsonPatch {
+	return []client.JsonPatch{
+		{
+			Op:   "add",
+			Path: "/services/permission/config/namespaces",
+			Value: orytypes.PermissionNamespaces{
+				Location: helpers.EncodeBase64URL(plan.OPL.ValueString()),
+			},
+		},
+	}
+}
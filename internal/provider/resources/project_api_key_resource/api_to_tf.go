@@ -0,0 +1,26 @@
+package project_api_key_resource
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/helpers"
+	"github.com/ory/client-go"
+)
+
+// ApiToProjectAPIKey maps the API key returned by the API onto the model. The
+// value is only returned on creation and kept as is otherwise.
+func ApiToProjectAPIKey(key *client.ProjectApiKey, tfConfig *projectAPIKeyResourceModel) {
+	tfConfig.ID = types.StringValue(key.Id)
+	tfConfig.Name = types.StringValue(key.Name)
+	tfConfig.OwnerID = types.StringValue(key.OwnerId)
+	tfConfig.ExpiresAt = helpers.TimestampOrState(key.ExpiresAt, tfConfig.ExpiresAt)
+
+	if key.CreatedAt != nil {
+		tfConfig.CreatedAt = types.StringValue(key.CreatedAt.Format(time.RFC3339))
+	}
+
+	if key.Value != nil {
+		tfConfig.Value = types.StringValue(*key.Value)
+	}
+}
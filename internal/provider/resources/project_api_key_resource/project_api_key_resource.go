@@ -0,0 +1,278 @@
+package project_api_key_resource
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	oryclient "github.com/kibblator/terraform-provider-ory/internal/provider/clients"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/custom_validators"
+	"github.com/ory/client-go"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &projectAPIKeyResource{}
+	_ resource.ResourceWithConfigure   = &projectAPIKeyResource{}
+	_ resource.ResourceWithImportState = &projectAPIKeyResource{}
+)
+
+// NewProjectAPIKeyResource is a helper function to simplify the provider implementation.
+func NewProjectAPIKeyResource() resource.Resource {
+	return &projectAPIKeyResource{}
+}
+
+// projectAPIKeyResource is the resource implementation.
+type projectAPIKeyResource struct {
+	oryClient *oryclient.OryClient
+}
+
+// projectAPIKeyResourceModel maps the resource schema data.
+type projectAPIKeyResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	LastUpdated types.String `tfsdk:"last_updated"`
+	Name        types.String `tfsdk:"name"`
+	ExpiresAt   types.String `tfsdk:"expires_at"`
+	Value       types.String `tfsdk:"value"`
+	OwnerID     types.String `tfsdk:"owner_id"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *projectAPIKeyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*oryclient.OryClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected OryClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.oryClient = client
+}
+
+// Metadata returns the resource type name.
+func (r *projectAPIKeyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_api_key"
+}
+
+// Schema defines the schema for the resource.
+func (r *projectAPIKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an API key of the project. Keys can't be changed, any change creates a new key. Use `create_before_destroy` to rotate a key without downtime.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the API key.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update of the API key.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the API key.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				Description: "RFC 3339 timestamp the API key expires at, for example `2030-01-01T00:00:00Z`. Without it the key doesn't expire.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					custom_validators.TimestampValidator{},
+				},
+			},
+			"value": schema.StringAttribute{
+				Description: "The secret value of the API key. It is only returned when the key is created, so it is not available after importing.",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"owner_id": schema.StringAttribute{
+				Description: "ID of the user owning the API key.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Timestamp the API key was created at.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Create a new resource.
+func (r *projectAPIKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan projectAPIKeyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, err := ProjectAPIKeyToApi(plan)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating ory project API key",
+			"Could not create ory project API key, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	key, _, err := r.oryClient.ConsoleAPIClient.ProjectAPI.CreateProjectApiKey(ctx, r.oryClient.ProjectID).CreateProjectApiKeyRequest(*body).Execute()
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating ory project API key",
+			"Could not create ory project API key, unexpected error: "+oryclient.ErrorDetail(err),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	ApiToProjectAPIKey(key, &plan)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *projectAPIKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading project API key resource")
+
+	// Retrieve current state
+	var state projectAPIKeyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// There is no endpoint returning a single API key
+	keys, _, err := r.oryClient.ConsoleAPIClient.ProjectAPI.ListProjectApiKeys(ctx, r.oryClient.ProjectID).Execute()
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error fetching ORY project API key",
+			"Could not retrieve ORY project API key: "+oryclient.ErrorDetail(err),
+		)
+		return
+	}
+
+	key := findProjectAPIKey(keys, state.ID.ValueString())
+
+	if key == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	ApiToProjectAPIKey(key, &state)
+
+	// Set the updated state
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update only refreshes the state, as every configurable attribute requires a
+// new API key.
+func (r *projectAPIKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan projectAPIKeyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set the updated plan to the state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *projectAPIKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state projectAPIKeyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.oryClient.ConsoleAPIClient.ProjectAPI.DeleteProjectApiKey(ctx, r.oryClient.ProjectID, state.ID.ValueString()).Execute()
+
+	if err != nil && (httpResp == nil || httpResp.StatusCode != http.StatusNotFound) {
+		resp.Diagnostics.AddError(
+			"Error deleting ory project API key",
+			"Could not delete ory project API key, unexpected error: "+oryclient.ErrorDetail(err),
+		)
+		return
+	}
+}
+
+func (r *projectAPIKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// findProjectAPIKey returns the API key with the given ID, or nil if the
+// project has no such key.
+func findProjectAPIKey(keys []client.ProjectApiKey, id string) *client.ProjectApiKey {
+	for i := range keys {
+		if keys[i].Id == id {
+			return &keys[i]
+		}
+	}
+
+	return nil
+}
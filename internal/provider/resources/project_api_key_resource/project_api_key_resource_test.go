@@ -0,0 +1,69 @@
+package project_api_key_resource_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kibblator/terraform-provider-ory/internal/provider/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccOryProjectAPIKeyResource(t *testing.T) {
+	randomName := acctest.GenerateRandomResourceName()
+	resourceName := fmt.Sprintf("ory_project_api_key.%s", randomName)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: fmt.Sprintf(`
+resource "ory_project_api_key" "%s" {
+  name       = "%s"
+  expires_at = "2099-01-01T00:00:00Z"
+}
+`, randomName, randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "value"),
+					resource.TestCheckResourceAttr(resourceName, "expires_at", "2099-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_updated"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"last_updated", // If 'last_updated' isn't returned from the ORY API, ignore it during import verification
+					"value",        // The value is only returned on creation
+				},
+			},
+			// Rotation replaces the key
+			{
+				Config: fmt.Sprintf(`
+resource "ory_project_api_key" "%s" {
+  name = "%s-rotated"
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+`, randomName, randomName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreateBeforeDestroy),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", randomName+"-rotated"),
+					resource.TestCheckNoResourceAttr(resourceName, "expires_at"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,25 @@
+package project_api_key_resource
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ory/client-go"
+)
+
+// ProjectAPIKeyToApi maps the model onto the API key creation request.
+func ProjectAPIKeyToApi(tfConfig projectAPIKeyResourceModel) (*client.CreateProjectApiKeyRequest, error) {
+	body := client.CreateProjectApiKeyRequest{
+		Name: tfConfig.Name.ValueString(),
+	}
+
+	if !tfConfig.ExpiresAt.IsNull() {
+		expiresAt, err := time.Parse(time.RFC3339, tfConfig.ExpiresAt.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("invalid expires_at: %w", err)
+		}
+		body.ExpiresAt = &expiresAt
+	}
+
+	return &body, nil
+}
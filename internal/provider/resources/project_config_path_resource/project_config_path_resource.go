@@ -0,0 +1,353 @@
+package project_config_path_resource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	oryclient "github.com/kibblator/terraform-provider-ory/internal/provider/clients"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/custom_validators"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/helpers"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/jsonpatch"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/ory/client-go"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &projectConfigPathResource{}
+	_ resource.ResourceWithConfigure      = &projectConfigPathResource{}
+	_ resource.ResourceWithImportState    = &projectConfigPathResource{}
+	_ resource.ResourceWithValidateConfig = &projectConfigPathResource{}
+)
+
+// NewProjectConfigPathResource is a helper function to simplify the provider implementation.
+func NewProjectConfigPathResource() resource.Resource {
+	return &projectConfigPathResource{}
+}
+
+// projectConfigPathResource is the resource implementation.
+type projectConfigPathResource struct {
+	oryClient *oryclient.OryClient
+}
+
+// projectConfigPathResourceModel maps the resource schema data.
+type projectConfigPathResourceModel struct {
+	ID          types.String         `tfsdk:"id"`
+	LastUpdated types.String         `tfsdk:"last_updated"`
+	Path        types.String         `tfsdk:"path"`
+	Value       jsontypes.Normalized `tfsdk:"value"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *projectConfigPathResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*oryclient.OryClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected OryClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.oryClient = client
+}
+
+// Metadata returns the resource type name.
+func (r *projectConfigPathResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_config_path"
+}
+
+// Schema defines the schema for the resource.
+func (r *projectConfigPathResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages an arbitrary value of the project configuration, addressed by a JSON pointer. Use it for settings not covered by a dedicated resource. Missing parent objects of the path are created.\n\n" +
+			"~> **Note:** Only overlaps with dedicated resources are detected. Terraform can't detect two `ory_project_config_path` resources managing nested paths, or a path within the configuration managed by `ory_project_config`. Such resources overwrite each other on every apply, so make sure every path is managed by a single resource.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "String identifier of the configuration path resource, equal to the path.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update of the configuration value.",
+				Computed:    true,
+			},
+			"path": schema.StringAttribute{
+				Description: "JSON pointer (RFC 6901) to the managed value within the project, for example `/services/identity/config/selfservice/flows/settings/privileged_session_max_age`.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					custom_validators.JsonPointerValidator{},
+				},
+			},
+			"value": schema.StringAttribute{
+				Description: "JSON encoded value of the configuration path. Formatting differences are ignored when comparing with the live configuration.",
+				CustomType:  jsontypes.NormalizedType{},
+				Required:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig reports paths that are already managed by a typed resource.
+func (r *projectConfigPathResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config projectConfigPathResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Path.IsNull() || config.Path.IsUnknown() {
+		return
+	}
+
+	conflicts := helpers.TypedResourceConflicts(config.Path.ValueString())
+
+	if len(conflicts) > 0 {
+		owners := make([]string, 0, len(conflicts))
+		for _, conflict := range conflicts {
+			owners = append(owners, fmt.Sprintf("%s (%s)", conflict, helpers.TypedResourcePaths[conflict]))
+		}
+
+		resp.Diagnostics.AddAttributeError(
+			path.Root("path"),
+			"Conflicting configuration path",
+			fmt.Sprintf("The path %q overlaps with configuration managed by typed resources: %s. Use those resources instead.", config.Path.ValueString(), strings.Join(owners, ", ")),
+		)
+	}
+}
+
+// Create a new resource.
+func (r *projectConfigPathResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan projectConfigPathResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var value interface{}
+	diags = plan.Value.Unmarshal(&value)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	document, err := r.oryClient.APIClient.GetProjectDocument(&r.oryClient.Mutex)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error fetching ORY project config",
+			"Could not retrieve ORY project configuration: "+err.Error(),
+		)
+		return
+	}
+
+	patch := jsonpatch.AddWithParents(document, plan.Path.ValueString(), value)
+
+	_, err = r.oryClient.APIClient.PatchProject(revisionID(document), patch, &r.oryClient.Mutex)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating ory project config path",
+			"Could not create ory project config path, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = plan.Path
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *projectConfigPathResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading project config path resource")
+
+	// Retrieve current state
+	var state projectConfigPathResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Fetch current project configuration from ORY
+	document, err := r.oryClient.APIClient.GetProjectDocument(&r.oryClient.Mutex)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error fetching ORY project config",
+			"Could not retrieve ORY project configuration: "+err.Error(),
+		)
+		return
+	}
+
+	value, found := jsonpatch.Get(document, state.Path.ValueString())
+
+	if !found {
+		tflog.Debug(ctx, "Configuration path no longer exists, removing from state", map[string]interface{}{
+			"path": state.Path.ValueString(),
+		})
+
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	encodedValue, err := json.Marshal(value)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error encoding ORY project config path",
+			"Could not encode the value of the configuration path: "+err.Error(),
+		)
+		return
+	}
+
+	state.ID = state.Path
+	state.Value = jsontypes.NewNormalizedValue(string(encodedValue))
+
+	// Set the updated state
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *projectConfigPathResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve the desired state from the plan
+	var plan projectConfigPathResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var value interface{}
+	diags = plan.Value.Unmarshal(&value)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	document, err := r.oryClient.APIClient.GetProjectDocument(&r.oryClient.Mutex)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error fetching ORY project config",
+			"Could not retrieve ORY project configuration: "+err.Error(),
+		)
+		return
+	}
+
+	// "add" replaces existing values, and recreates the path if it was removed out of band
+	patch := jsonpatch.AddWithParents(document, plan.Path.ValueString(), value)
+
+	_, err = r.oryClient.APIClient.PatchProject(revisionID(document), patch, &r.oryClient.Mutex)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating ory project config path",
+			"Could not update ory project config path, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = plan.Path
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set the updated plan to the state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete removes the configuration path and the Terraform state on success.
+func (r *projectConfigPathResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state projectConfigPathResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	document, err := r.oryClient.APIClient.GetProjectDocument(&r.oryClient.Mutex)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error fetching ORY project config",
+			"Could not retrieve ORY project configuration: "+err.Error(),
+		)
+		return
+	}
+
+	if _, found := jsonpatch.Get(document, state.Path.ValueString()); !found {
+		return
+	}
+
+	patch := []client.JsonPatch{
+		{
+			Op:   "remove",
+			Path: state.Path.ValueString(),
+		},
+	}
+
+	_, err = r.oryClient.APIClient.PatchProject(revisionID(document), patch, &r.oryClient.Mutex)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting ory project config path",
+			"Could not delete ory project config path, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *projectConfigPathResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id and path attributes
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughID(ctx, path.Root("path"), req, resp)
+}
+
+// revisionID returns the revision of the project document, which patches are
+// applied against.
+func revisionID(document map[string]interface{}) string {
+	revision, _ := document["revision_id"].(string)
+	return revision
+}
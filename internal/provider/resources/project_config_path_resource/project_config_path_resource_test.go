@@ -0,0 +1,67 @@
+package project_config_path_resource_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/kibblator/terraform-provider-ory/internal/provider/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOryProjectConfigPathResource(t *testing.T) {
+	randomName := acctest.GenerateRandomResourceName()
+	resourceName := fmt.Sprintf("ory_project_config_path.%s", randomName)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccOryProjectConfigPath(randomName, `{ enabled = true, use = "code" }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "/services/identity/config/selfservice/flows/recovery"),
+					resource.TestCheckResourceAttr(resourceName, "value", `{"enabled":true,"use":"code"}`),
+					resource.TestCheckResourceAttrSet(resourceName, "last_updated"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"last_updated", // If 'last_updated' isn't returned from the ORY API, ignore it during import verification
+				},
+			},
+			// Update and Read testing
+			{
+				Config: testAccOryProjectConfigPath(randomName, `{ enabled = false, use = "link" }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "value", `{"enabled":false,"use":"link"}`),
+				),
+			},
+			// Paths owned by typed resources are rejected
+			{
+				Config: fmt.Sprintf(`
+resource "ory_project_config_path" "%s" {
+  path  = "/services/identity/config/courier"
+  value = jsonencode({})
+}
+`, randomName),
+				ExpectError: regexp.MustCompile("Conflicting configuration path"),
+			},
+		},
+	})
+}
+
+func testAccOryProjectConfigPath(randomName string, value string) string {
+	return fmt.Sprintf(`
+resource "ory_project_config_path" "%s" {
+  path  = "/services/identity/config/selfservice/flows/recovery"
+  value = jsonencode(%s)
+}
+`, randomName, value)
+}
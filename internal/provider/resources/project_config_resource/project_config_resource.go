@@ -0,0 +1,396 @@
+package project_config_resource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	oryclient "github.com/kibblator/terraform-provider-ory/internal/provider/clients"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/helpers"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/jsonpatch"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// identityConfigPath is the JSON pointer of the identity configuration within the project.
+const identityConfigPath = "/services/identity/config"
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &projectConfigResource{}
+	_ resource.ResourceWithConfigure      = &projectConfigResource{}
+	_ resource.ResourceWithImportState    = &projectConfigResource{}
+	_ resource.ResourceWithModifyPlan     = &projectConfigResource{}
+	_ resource.ResourceWithValidateConfig = &projectConfigResource{}
+)
+
+// NewProjectConfigResource is a helper function to simplify the provider implementation.
+func NewProjectConfigResource() resource.Resource {
+	return &projectConfigResource{}
+}
+
+// projectConfigResource is the resource implementation.
+type projectConfigResource struct {
+	oryClient *oryclient.OryClient
+}
+
+// projectConfigResourceModel maps the resource schema data.
+type projectConfigResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	LastUpdated      types.String `tfsdk:"last_updated"`
+	Config           types.String `tfsdk:"config"`
+	NormalizedConfig types.String `tfsdk:"normalized_config"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *projectConfigResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*oryclient.OryClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected OryClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.oryClient = client
+}
+
+// Metadata returns the resource type name.
+func (r *projectConfigResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_config"
+}
+
+// Schema defines the schema for the resource.
+func (r *projectConfigResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the identity configuration of the project as a single document. Only the settings present in the document are managed, defaults filled in by Ory are ignored. Destroying the resource leaves the configuration unchanged. Importing takes over the whole identity configuration, so settings missing from the document are removed on the next apply.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "String identifier of the project configuration resource.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update of the project configuration.",
+				Computed:    true,
+			},
+			"config": schema.StringAttribute{
+				Description: "Identity configuration as a JSON or YAML document, for example as exported by `ory get identity-config`.",
+				Required:    true,
+			},
+			"normalized_config": schema.StringAttribute{
+				Description: "Canonical JSON encoding of the managed identity configuration, used to show configuration changes and drift.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks the document can be parsed and warns about settings
+// managed by typed resources.
+func (r *projectConfigResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config projectConfigResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Config.IsNull() || config.Config.IsUnknown() {
+		return
+	}
+
+	document, err := helpers.ParseDocument(config.Config.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("config"),
+			"Invalid configuration document",
+			"The configuration must be a JSON or YAML document: "+err.Error(),
+		)
+		return
+	}
+
+	if _, ok := document.(map[string]interface{}); !ok {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("config"),
+			"Invalid configuration document",
+			"The configuration document must be an object.",
+		)
+		return
+	}
+
+	owned := []string{}
+	for typedPath, resourceType := range helpers.TypedResourcePaths {
+		if !strings.HasPrefix(typedPath, identityConfigPath+"/") {
+			continue
+		}
+
+		if _, found := jsonpatch.Get(document, strings.TrimPrefix(typedPath, identityConfigPath)); found {
+			owned = append(owned, fmt.Sprintf("%s (%s)", typedPath, resourceType))
+		}
+	}
+
+	sort.Strings(owned)
+
+	if len(owned) > 0 {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("config"),
+			"Configuration overlaps with typed resources",
+			fmt.Sprintf("The configuration document sets values that typed resources can manage: %s. Managing them with both will cause perpetual differences.", strings.Join(owned, ", ")),
+		)
+	}
+}
+
+// ModifyPlan shows the normalized configuration in the plan.
+func (r *projectConfigResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to normalize when the resource is destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan projectConfigResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Config.IsUnknown() {
+		plan.NormalizedConfig = types.StringUnknown()
+	} else {
+		normalized, err := helpers.NormalizeDocument(plan.Config.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("config"),
+				"Invalid configuration document",
+				"The configuration must be a JSON or YAML document: "+err.Error(),
+			)
+			return
+		}
+
+		plan.NormalizedConfig = types.StringValue(normalized)
+	}
+
+	diags = resp.Plan.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Create a new resource.
+func (r *projectConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan projectConfigResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.applyConfig(&plan, types.StringNull())
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating ory project config",
+			"Could not create ory project config, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.StringValue("project_config")
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *projectConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading project config resource")
+
+	// Retrieve current state
+	var state projectConfigResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	live, err := r.liveConfig()
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error fetching ORY project config",
+			"Could not retrieve ORY project configuration: "+err.Error(),
+		)
+		return
+	}
+
+	// Imported resources take over the whole configuration, otherwise only
+	// the settings previously applied are compared
+	if !state.NormalizedConfig.IsNull() {
+		var managed interface{}
+		if err := json.Unmarshal([]byte(state.NormalizedConfig.ValueString()), &managed); err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading ORY project config",
+				"Could not decode the normalized configuration in state: "+err.Error(),
+			)
+			return
+		}
+
+		live = jsonpatch.Project(live, managed)
+	}
+
+	normalized, err := json.Marshal(live)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading ORY project config",
+			"Could not encode the project configuration: "+err.Error(),
+		)
+		return
+	}
+
+	if state.Config.IsNull() {
+		state.Config = types.StringValue(string(normalized))
+	}
+
+	state.ID = types.StringValue("project_config")
+	state.NormalizedConfig = types.StringValue(string(normalized))
+
+	// Set the updated state
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *projectConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve the desired state from the plan
+	var plan projectConfigResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state projectConfigResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.applyConfig(&plan, state.NormalizedConfig)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating ory project config",
+			"Could not update ory project config, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set the updated plan to the state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *projectConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+func (r *projectConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// applyConfig patches the live configuration with the minimal set of
+// operations turning the settings managed so far into the planned document.
+func (r *projectConfigResource) applyConfig(plan *projectConfigResourceModel, previous types.String) error {
+	normalized, err := helpers.NormalizeDocument(plan.Config.ValueString())
+	if err != nil {
+		return err
+	}
+
+	plan.NormalizedConfig = types.StringValue(normalized)
+
+	var desired interface{}
+	if err := json.Unmarshal([]byte(normalized), &desired); err != nil {
+		return fmt.Errorf("error decoding configuration: %v", err)
+	}
+
+	shapes := []interface{}{desired}
+
+	if !previous.IsNull() {
+		var managed interface{}
+		if err := json.Unmarshal([]byte(previous.ValueString()), &managed); err != nil {
+			return fmt.Errorf("error decoding previous configuration: %v", err)
+		}
+
+		shapes = append(shapes, managed)
+	}
+
+	live, err := r.liveConfig()
+	if err != nil {
+		return err
+	}
+
+	patch := jsonpatch.DiffAt(identityConfigPath, jsonpatch.Project(live, shapes...), desired)
+
+	if len(patch) == 0 {
+		return nil
+	}
+
+	project, err := r.oryClient.APIClient.GetProject(&r.oryClient.Mutex)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.oryClient.APIClient.PatchProject(project.RevisionId, patch, &r.oryClient.Mutex)
+
+	return err
+}
+
+func (r *projectConfigResource) liveConfig() (interface{}, error) {
+	document, err := r.oryClient.APIClient.GetProjectDocument(&r.oryClient.Mutex)
+	if err != nil {
+		return nil, err
+	}
+
+	live, found := jsonpatch.Get(document, identityConfigPath)
+	if !found {
+		return map[string]interface{}{}, nil
+	}
+
+	return live, nil
+}
@@ -0,0 +1,64 @@
+package project_config_resource_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kibblator/terraform-provider-ory/internal/provider/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOryProjectConfigResource(t *testing.T) {
+	randomName := acctest.GenerateRandomResourceName()
+	resourceName := fmt.Sprintf("ory_project_config.%s", randomName)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			// Create and Read testing from YAML
+			{
+				Config: fmt.Sprintf(`
+resource "ory_project_config" "%s" {
+  config = <<-EOT
+    selfservice:
+      flows:
+        recovery:
+          enabled: true
+          use: code
+  EOT
+}
+`, randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "project_config"),
+					resource.TestCheckResourceAttr(resourceName, "normalized_config", `{"selfservice":{"flows":{"recovery":{"enabled":true,"use":"code"}}}}`),
+					resource.TestCheckResourceAttrSet(resourceName, "last_updated"),
+				),
+			},
+			// Update and Read testing from JSON
+			{
+				Config: fmt.Sprintf(`
+resource "ory_project_config" "%s" {
+  config = jsonencode({
+    selfservice = {
+      flows = {
+        recovery = {
+          enabled = false
+          use     = "code"
+        }
+        verification = {
+          enabled = true
+        }
+      }
+    }
+  })
+}
+`, randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "normalized_config", `{"selfservice":{"flows":{"recovery":{"enabled":false,"use":"code"},"verification":{"enabled":true}}}}`),
+				),
+			},
+		},
+	})
+}
@@ -180,7 +180,16 @@ func (r *registrationResource) Create(ctx context.Context, req resource.CreateRe
 		}
 	}
 
-	project, _ := r.oryClient.APIClient.GetProject(&r.oryClient.Mutex)
+	project, err := r.oryClient.APIClient.GetProject(&r.oryClient.Mutex)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error fetching ORY project config",
+			"Could not retrieve ORY project configuration: "+err.Error(),
+		)
+		return
+	}
+
 	projectUpdate, err := r.oryClient.APIClient.PatchProject(project.RevisionId, patch, &r.oryClient.Mutex)
 
 	if err != nil {
@@ -313,7 +322,16 @@ func (r *registrationResource) Update(ctx context.Context, req resource.UpdateRe
 		}
 	}
 
-	project, _ := r.oryClient.APIClient.GetProject(&r.oryClient.Mutex)
+	project, err := r.oryClient.APIClient.GetProject(&r.oryClient.Mutex)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error fetching ORY project config",
+			"Could not retrieve ORY project configuration: "+err.Error(),
+		)
+		return
+	}
+
 	projectUpdate, err := r.oryClient.APIClient.PatchProject(project.RevisionId, patch, &r.oryClient.Mutex)
 
 	if err != nil {
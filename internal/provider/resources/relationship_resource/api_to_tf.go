@@ -0,0 +1,25 @@
+package relationship_resource
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/helpers"
+	"github.com/ory/client-go"
+)
+
+// ApiToRelationship maps a relation tuple onto the model.
+func ApiToRelationship(relationship client.Relationship, tfConfig *relationshipResourceModel) {
+	tfConfig.ID = types.StringValue(helpers.FormatRelationship(relationship))
+	tfConfig.Namespace = types.StringValue(relationship.Namespace)
+	tfConfig.Object = types.StringValue(relationship.Object)
+	tfConfig.Relation = types.StringValue(relationship.Relation)
+	tfConfig.SubjectID = types.StringPointerValue(relationship.SubjectId)
+	tfConfig.SubjectSet = nil
+
+	if set := relationship.SubjectSet; set != nil {
+		tfConfig.SubjectSet = &SubjectSet{
+			Namespace: types.StringValue(set.Namespace),
+			Object:    types.StringValue(set.Object),
+			Relation:  helpers.StringOrNil(set.Relation),
+		}
+	}
+}
@@ -0,0 +1,282 @@
+package relationship_resource
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	oryclient "github.com/kibblator/terraform-provider-ory/internal/provider/clients"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/helpers"
+	"github.com/ory/client-go"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &relationshipResource{}
+	_ resource.ResourceWithConfigure   = &relationshipResource{}
+	_ resource.ResourceWithImportState = &relationshipResource{}
+)
+
+// NewRelationshipResource is a helper function to simplify the provider implementation.
+func NewRelationshipResource() resource.Resource {
+	return &relationshipResource{}
+}
+
+// relationshipResource is the resource implementation.
+type relationshipResource struct {
+	oryClient *oryclient.OryClient
+}
+
+type SubjectSet struct {
+	Namespace types.String `tfsdk:"namespace"`
+	Object    types.String `tfsdk:"object"`
+	Relation  types.String `tfsdk:"relation"`
+}
+
+// relationshipResourceModel maps the resource schema data.
+type relationshipResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	LastUpdated types.String `tfsdk:"last_updated"`
+	Namespace   types.String `tfsdk:"namespace"`
+	Object      types.String `tfsdk:"object"`
+	Relation    types.String `tfsdk:"relation"`
+	SubjectID   types.String `tfsdk:"subject_id"`
+	SubjectSet  *SubjectSet  `tfsdk:"subject_set"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *relationshipResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*oryclient.OryClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected OryClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.oryClient = client
+}
+
+// Metadata returns the resource type name.
+func (r *relationshipResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_relationship"
+}
+
+func requiredString(description string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: description,
+		Required:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+// Schema defines the schema for the resource.
+func (r *relationshipResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a single Ory Permissions relationship (relation tuple). Relationships are immutable, any change replaces them.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The relationship in the notation `namespace:object#relation@subject`.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update of the relationship.",
+				Computed:    true,
+			},
+			"namespace": requiredString("Namespace of the object."),
+			"object":    requiredString("The object the relationship is about."),
+			"relation":  requiredString("The relation between the object and the subject."),
+			"subject_id": schema.StringAttribute{
+				Description: "ID of the subject. Exactly one of `subject_id` and `subject_set` must be set.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("subject_set")),
+				},
+			},
+			"subject_set": schema.SingleNestedAttribute{
+				Description: "Set of subjects, all subjects having `relation` to `object` in `namespace`.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"namespace": schema.StringAttribute{
+						Description: "Namespace of the subject set object.",
+						Required:    true,
+					},
+					"object": schema.StringAttribute{
+						Description: "Object of the subject set.",
+						Required:    true,
+					},
+					"relation": schema.StringAttribute{
+						Description: "Relation of the subject set. Leave out to reference the object itself.",
+						Optional:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// Create a new resource.
+func (r *relationshipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan relationshipResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	relationship := RelationshipToApi(plan)
+
+	body := client.CreateRelationshipBody{
+		Namespace:  &relationship.Namespace,
+		Object:     &relationship.Object,
+		Relation:   &relationship.Relation,
+		SubjectId:  relationship.SubjectId,
+		SubjectSet: relationship.SubjectSet,
+	}
+
+	_, _, err := r.oryClient.ProjectAPIClient.RelationshipAPI.CreateRelationship(ctx).CreateRelationshipBody(body).Execute()
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating ory relationship",
+			"Could not create ory relationship, unexpected error: "+oryclient.ErrorDetail(err),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.StringValue(helpers.FormatRelationship(relationship))
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *relationshipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading relationship resource")
+
+	// Retrieve current state
+	var state relationshipResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Imported relationships only have their ID
+	relationship, err := helpers.ParseRelationship(state.ID.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid ORY relationship ID",
+			"Could not parse the relationship ID: "+err.Error(),
+		)
+		return
+	}
+
+	found, err := oryclient.RelationshipExists(ctx, r.oryClient.ProjectAPIClient, relationship)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error fetching ORY relationship",
+			"Could not retrieve ORY relationship: "+oryclient.ErrorDetail(err),
+		)
+		return
+	}
+
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	ApiToRelationship(relationship, &state)
+
+	// Set the updated state
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update only refreshes the state, every attribute of a relationship requires
+// a replacement.
+func (r *relationshipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve the desired state from the plan
+	var plan relationshipResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set the updated plan to the state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *relationshipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state relationshipResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := oryclient.DeleteRelationship(ctx, r.oryClient.ProjectAPIClient, RelationshipToApi(state))
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting ory relationship",
+			"Could not delete ory relationship, unexpected error: "+oryclient.ErrorDetail(err),
+		)
+		return
+	}
+}
+
+func (r *relationshipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,88 @@
+package relationship_resource_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kibblator/terraform-provider-ory/internal/provider/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const namespacesConfig = `
+resource "ory_permission_namespaces" "namespaces" {
+  opl = <<-EOT
+    import { Namespace } from "@ory/keto-namespace-types"
+
+    class User implements Namespace {}
+
+    class Group implements Namespace {
+      related: {
+        members: (User | SubjectSet<Group, "members">)[]
+      }
+    }
+  EOT
+}
+`
+
+func TestAccOryRelationshipResource(t *testing.T) {
+	randomName := acctest.GenerateRandomResourceName()
+	resourceName := fmt.Sprintf("ory_relationship.%s", randomName)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: namespacesConfig + fmt.Sprintf(`
+resource "ory_relationship" "%s" {
+  namespace  = "Group"
+  object     = "%s"
+  relation   = "members"
+  subject_id = "user-1"
+
+  depends_on = [ory_permission_namespaces.namespaces]
+}
+`, randomName, randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("Group:%s#members@user-1", randomName)),
+					resource.TestCheckResourceAttr(resourceName, "subject_id", "user-1"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_updated"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"last_updated", // If 'last_updated' isn't returned from the ORY API, ignore it during import verification
+				},
+			},
+			// Replace with a subject set
+			{
+				Config: namespacesConfig + fmt.Sprintf(`
+resource "ory_relationship" "%s" {
+  namespace = "Group"
+  object    = "%s"
+  relation  = "members"
+
+  subject_set = {
+    namespace = "Group"
+    object    = "admins"
+    relation  = "members"
+  }
+
+  depends_on = [ory_permission_namespaces.namespaces]
+}
+`, randomName, randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", fmt.Sprintf("Group:%s#members@Group:admins#members", randomName)),
+					resource.TestCheckResourceAttr(resourceName, "subject_set.object", "admins"),
+					resource.TestCheckNoResourceAttr(resourceName, "subject_id"),
+				),
+			},
+		},
+	})
+}
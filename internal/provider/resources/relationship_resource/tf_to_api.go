@@ -0,0 +1,25 @@
+package relationship_resource
+
+import (
+	"github.com/ory/client-go"
+)
+
+// RelationshipToApi returns the relation tuple described by the model.
+func RelationshipToApi(tfConfig relationshipResourceModel) client.Relationship {
+	relationship := client.Relationship{
+		Namespace: tfConfig.Namespace.ValueString(),
+		Object:    tfConfig.Object.ValueString(),
+		Relation:  tfConfig.Relation.ValueString(),
+		SubjectId: tfConfig.SubjectID.ValueStringPointer(),
+	}
+
+	if tfConfig.SubjectSet != nil {
+		relationship.SubjectSet = &client.SubjectSet{
+			Namespace: tfConfig.SubjectSet.Namespace.ValueString(),
+			Object:    tfConfig.SubjectSet.Object.ValueString(),
+			Relation:  tfConfig.SubjectSet.Relation.ValueString(),
+		}
+	}
+
+	return relationship
+}
@@ -0,0 +1,289 @@
+package relationships_resource
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	oryclient "github.com/kibblator/terraform-provider-ory/internal/provider/clients"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/helpers"
+	"github.com/ory/client-go"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &relationshipsResource{}
+	_ resource.ResourceWithConfigure = &relationshipsResource{}
+)
+
+// NewRelationshipsResource is a helper function to simplify the provider implementation.
+func NewRelationshipsResource() resource.Resource {
+	return &relationshipsResource{}
+}
+
+// relationshipsResource is the resource implementation.
+type relationshipsResource struct {
+	oryClient *oryclient.OryClient
+}
+
+type SubjectSet struct {
+	Namespace types.String `tfsdk:"namespace"`
+	Object    types.String `tfsdk:"object"`
+	Relation  types.String `tfsdk:"relation"`
+}
+
+type Relationship struct {
+	Namespace  types.String `tfsdk:"namespace"`
+	Object     types.String `tfsdk:"object"`
+	Relation   types.String `tfsdk:"relation"`
+	SubjectID  types.String `tfsdk:"subject_id"`
+	SubjectSet *SubjectSet  `tfsdk:"subject_set"`
+}
+
+// relationshipsResourceModel maps the resource schema data.
+type relationshipsResourceModel struct {
+	ID            types.String   `tfsdk:"id"`
+	LastUpdated   types.String   `tfsdk:"last_updated"`
+	Relationships []Relationship `tfsdk:"relationships"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *relationshipsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*oryclient.OryClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected OryClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.oryClient = client
+}
+
+// Metadata returns the resource type name.
+func (r *relationshipsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_relationships"
+}
+
+// Schema defines the schema for the resource.
+func (r *relationshipsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a set of Ory Permissions relationships (relation tuples). Changes are applied in a single transactional patch.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "String identifier of the relationships resource.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update of the relationships.",
+				Computed:    true,
+			},
+			"relationships": schema.SetNestedAttribute{
+				Description: "The managed relationships.",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"namespace": schema.StringAttribute{
+							Description: "Namespace of the object.",
+							Required:    true,
+						},
+						"object": schema.StringAttribute{
+							Description: "The object the relationship is about.",
+							Required:    true,
+						},
+						"relation": schema.StringAttribute{
+							Description: "The relation between the object and the subject.",
+							Required:    true,
+						},
+						"subject_id": schema.StringAttribute{
+							Description: "ID of the subject. Exactly one of `subject_id` and `subject_set` must be set.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("subject_set")),
+							},
+						},
+						"subject_set": schema.SingleNestedAttribute{
+							Description: "Set of subjects, all subjects having `relation` to `object` in `namespace`.",
+							Optional:    true,
+							Attributes: map[string]schema.Attribute{
+								"namespace": schema.StringAttribute{
+									Description: "Namespace of the subject set object.",
+									Required:    true,
+								},
+								"object": schema.StringAttribute{
+									Description: "Object of the subject set.",
+									Required:    true,
+								},
+								"relation": schema.StringAttribute{
+									Description: "Relation of the subject set. Leave out to reference the object itself.",
+									Optional:    true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Create a new resource.
+func (r *relationshipsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan relationshipsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planned := RelationshipsToApi(plan.Relationships)
+
+	err := r.patch(ctx, nil, planned)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating ory relationships",
+			"Could not create ory relationships, unexpected error: "+oryclient.ErrorDetail(err),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.StringValue("relationships:" + helpers.ContentID(strings.Join(sortedKeys(planned), ",")))
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *relationshipsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading relationships resource")
+
+	// Retrieve current state
+	var state relationshipsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Drop the relationships deleted outside of Terraform so they are recreated
+	relationships := []Relationship{}
+
+	for _, relationship := range state.Relationships {
+		found, err := oryclient.RelationshipExists(ctx, r.oryClient.ProjectAPIClient, relationshipToApi(relationship))
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error fetching ORY relationships",
+				"Could not retrieve ORY relationships: "+oryclient.ErrorDetail(err),
+			)
+			return
+		}
+
+		if found {
+			relationships = append(relationships, relationship)
+		}
+	}
+
+	state.Relationships = relationships
+
+	// Set the updated state
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *relationshipsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve the desired state from the plan
+	var plan, state relationshipsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.patch(ctx, RelationshipsToApi(state.Relationships), RelationshipsToApi(plan.Relationships))
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating ory relationships",
+			"Could not update ory relationships, unexpected error: "+oryclient.ErrorDetail(err),
+		)
+		return
+	}
+
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set the updated plan to the state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *relationshipsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state relationshipsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.patch(ctx, RelationshipsToApi(state.Relationships), nil)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting ory relationships",
+			"Could not delete ory relationships, unexpected error: "+oryclient.ErrorDetail(err),
+		)
+		return
+	}
+}
+
+// patch applies the difference between the previous and planned tuples in a
+// single transaction.
+func (r *relationshipsResource) patch(ctx context.Context, previous, planned map[string]client.Relationship) error {
+	patch := RelationshipsPatch(previous, planned)
+
+	if len(patch) == 0 {
+		return nil
+	}
+
+	_, err := r.oryClient.ProjectAPIClient.RelationshipAPI.PatchRelationships(ctx).RelationshipPatch(patch).Execute()
+
+	return err
+}
@@ -0,0 +1,83 @@
+package relationships_resource_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kibblator/terraform-provider-ory/internal/provider/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const namespacesConfig = `
+resource "ory_permission_namespaces" "namespaces" {
+  opl = <<-EOT
+    import { Namespace } from "@ory/keto-namespace-types"
+
+    class User implements Namespace {}
+
+    class Group implements Namespace {
+      related: {
+        members: (User | SubjectSet<Group, "members">)[]
+      }
+    }
+  EOT
+}
+`
+
+func TestAccOryRelationshipsResource(t *testing.T) {
+	randomName := acctest.GenerateRandomResourceName()
+	resourceName := fmt.Sprintf("ory_relationships.%s", randomName)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: namespacesConfig + fmt.Sprintf(`
+resource "ory_relationships" "%s" {
+  relationships = [
+    { namespace = "Group", object = "%s", relation = "members", subject_id = "user-1" },
+    { namespace = "Group", object = "%s", relation = "members", subject_id = "user-2" },
+  ]
+
+  depends_on = [ory_permission_namespaces.namespaces]
+}
+`, randomName, randomName, randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "relationships.#", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_updated"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: namespacesConfig + fmt.Sprintf(`
+resource "ory_relationships" "%s" {
+  relationships = [
+    { namespace = "Group", object = "%s", relation = "members", subject_id = "user-2" },
+    {
+      namespace   = "Group"
+      object      = "%s"
+      relation    = "members"
+      subject_set = { namespace = "Group", object = "admins", relation = "members" }
+    },
+  ]
+
+  depends_on = [ory_permission_namespaces.namespaces]
+}
+`, randomName, randomName, randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "relationships.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "relationships.*", map[string]string{
+						"subject_id": "user-2",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "relationships.*", map[string]string{
+						"subject_set.object": "admins",
+					}),
+				),
+			},
+		},
+	})
+}
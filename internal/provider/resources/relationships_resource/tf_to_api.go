@@ -0,0 +1,78 @@
+package relationships_resource
+
+import (
+	"sort"
+
+	"github.com/kibblator/terraform-provider-ory/internal/provider/helpers"
+	"github.com/ory/client-go"
+)
+
+// RelationshipsToApi returns the relation tuples of the model keyed by their
+// string notation.
+func RelationshipsToApi(relationships []Relationship) map[string]client.Relationship {
+	tuples := make(map[string]client.Relationship, len(relationships))
+
+	for _, tfRelationship := range relationships {
+		relationship := relationshipToApi(tfRelationship)
+		tuples[helpers.FormatRelationship(relationship)] = relationship
+	}
+
+	return tuples
+}
+
+func relationshipToApi(tfRelationship Relationship) client.Relationship {
+	relationship := client.Relationship{
+		Namespace: tfRelationship.Namespace.ValueString(),
+		Object:    tfRelationship.Object.ValueString(),
+		Relation:  tfRelationship.Relation.ValueString(),
+		SubjectId: tfRelationship.SubjectID.ValueStringPointer(),
+	}
+
+	if tfRelationship.SubjectSet != nil {
+		relationship.SubjectSet = &client.SubjectSet{
+			Namespace: tfRelationship.SubjectSet.Namespace.ValueString(),
+			Object:    tfRelationship.SubjectSet.Object.ValueString(),
+			Relation:  tfRelationship.SubjectSet.Relation.ValueString(),
+		}
+	}
+
+	return relationship
+}
+
+// RelationshipsPatch returns the patch turning the previous tuples into the
+// planned ones, deleting tuples before inserting new ones.
+func RelationshipsPatch(previous, planned map[string]client.Relationship) []client.RelationshipPatch {
+	patch := []client.RelationshipPatch{}
+
+	for _, key := range sortedKeys(previous) {
+		if _, keep := planned[key]; !keep {
+			patch = append(patch, relationshipPatch("delete", previous[key]))
+		}
+	}
+
+	for _, key := range sortedKeys(planned) {
+		if _, exists := previous[key]; !exists {
+			patch = append(patch, relationshipPatch("insert", planned[key]))
+		}
+	}
+
+	return patch
+}
+
+func relationshipPatch(action string, relationship client.Relationship) client.RelationshipPatch {
+	return client.RelationshipPatch{
+		Action:        &action,
+		RelationTuple: &relationship,
+	}
+}
+
+func sortedKeys(tuples map[string]client.Relationship) []string {
+	keys := make([]string, 0, len(tuples))
+	for key := range tuples {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,23 @@
+package saml_provider_resource
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/helpers"
+	orytypes "github.com/kibblator/terraform-provider-ory/internal/provider/types"
+)
+
+// ApiToSAMLProvider maps the provider configuration onto the model.
+func ApiToSAMLProvider(provider orytypes.Provider, tfConfig *samlProviderResourceModel) {
+	tfConfig.ID = types.StringValue(provider.ID)
+	tfConfig.ProviderID = types.StringValue(provider.ID)
+	tfConfig.Label = helpers.StringOrNil(provider.Label)
+	tfConfig.IDPMetadataURL = helpers.StringOrNil(provider.IDPMetadataURL)
+	tfConfig.EntityID = helpers.StringOrNil(provider.EntityID)
+	tfConfig.MapperURL = helpers.StringOrNil(provider.MapperURL)
+	tfConfig.OrganizationID = helpers.StringOrNil(provider.OrganizationID)
+	tfConfig.IDPMetadataXML = types.StringNull()
+
+	if metadata, ok := helpers.DecodeBase64URL(provider.RawIDPMetadata); ok {
+		tfConfig.IDPMetadataXML = types.StringValue(metadata)
+	}
+}
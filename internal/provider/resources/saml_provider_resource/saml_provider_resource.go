@@ -0,0 +1,392 @@
+package saml_provider_resource
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	oryclient "github.com/kibblator/terraform-provider-ory/internal/provider/clients"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/custom_validators"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/helpers"
+	orytypes "github.com/kibblator/terraform-provider-ory/internal/provider/types"
+)
+
+// certificateExpiryWarning is how long before expiry a signing certificate is reported.
+const certificateExpiryWarning = 30 * 24 * time.Hour
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &samlProviderResource{}
+	_ resource.ResourceWithConfigure      = &samlProviderResource{}
+	_ resource.ResourceWithImportState    = &samlProviderResource{}
+	_ resource.ResourceWithValidateConfig = &samlProviderResource{}
+	_ resource.ResourceWithModifyPlan     = &samlProviderResource{}
+)
+
+// NewSAMLProviderResource is a helper function to simplify the provider implementation.
+func NewSAMLProviderResource() resource.Resource {
+	return &samlProviderResource{}
+}
+
+// samlProviderResource is the resource implementation.
+type samlProviderResource struct {
+	oryClient *oryclient.OryClient
+}
+
+// samlProviderResourceModel maps the resource schema data.
+type samlProviderResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	LastUpdated    types.String `tfsdk:"last_updated"`
+	ProviderID     types.String `tfsdk:"provider_id"`
+	Label          types.String `tfsdk:"label"`
+	IDPMetadataURL types.String `tfsdk:"idp_metadata_url"`
+	IDPMetadataXML types.String `tfsdk:"idp_metadata_xml"`
+	EntityID       types.String `tfsdk:"entity_id"`
+	MapperURL      types.String `tfsdk:"mapper_url"`
+	OrganizationID types.String `tfsdk:"organization_id"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *samlProviderResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*oryclient.OryClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected OryClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.oryClient = client
+}
+
+// Metadata returns the resource type name.
+func (r *samlProviderResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_saml_provider"
+}
+
+// Schema defines the schema for the resource.
+func (r *samlProviderResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a SAML identity provider of the project. The SAML sign-in method is enabled when needed. Inline metadata is checked locally, including the validity of its signing certificates.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "String identifier of the SAML provider, equal to the provider ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update of the SAML provider.",
+				Computed:    true,
+			},
+			"provider_id": schema.StringAttribute{
+				Description: "Unique ID of the provider, used in the assertion consumer service URL. Changing it recreates the provider.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(providerIDPattern, "must only contain lowercase letters, digits, `-` and `_`"),
+				},
+			},
+			"label": schema.StringAttribute{
+				Description: "Label of the provider shown on the sign-in button.",
+				Optional:    true,
+			},
+			"idp_metadata_url": schema.StringAttribute{
+				Description: "URL the metadata of the identity provider is fetched from. Exactly one of `idp_metadata_url` and `idp_metadata_xml` must be set. The metadata is fetched at plan time to check its signing certificates the same way as inline metadata, a failing fetch is reported as a warning.",
+				Optional:    true,
+				Validators: []validator.String{
+					custom_validators.URLValidator{Schemes: []string{"http", "https"}},
+					stringvalidator.ExactlyOneOf(path.MatchRoot("idp_metadata_xml")),
+				},
+			},
+			"idp_metadata_xml": schema.StringAttribute{
+				Description: "Metadata XML of the identity provider. It must contain a signing certificate that is currently valid, certificates expiring within 30 days are reported as warnings.",
+				Optional:    true,
+			},
+			"entity_id": schema.StringAttribute{
+				Description: "Entity ID of the identity provider. When inline metadata is given it has to match its `entityID`.",
+				Optional:    true,
+			},
+			"mapper_url": schema.StringAttribute{
+				Description: "URL of the Jsonnet mapping the SAML attributes to identity traits, for example `base64://...` or `https://...`. Local files can be inlined as `base64://${provider::ory::jsonnet_body(file(...))}`.",
+				Required:    true,
+				Validators: []validator.String{
+					custom_validators.URLValidator{Schemes: []string{"http", "https", "base64"}},
+				},
+			},
+			"organization_id": schema.StringAttribute{
+				Description: "ID of the organization the provider is limited to. Without it the provider is available to all users.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig parses inline metadata and checks its signing certificates.
+func (r *samlProviderResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config samlProviderResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.IDPMetadataXML.IsNull() || config.IDPMetadataXML.IsUnknown() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkMetadata(config.IDPMetadataXML.ValueString(), config.EntityID, path.Root("idp_metadata_xml"))...)
+}
+
+// ModifyPlan fetches metadata given by URL and checks it like inline metadata.
+func (r *samlProviderResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do when the resource is being destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan samlProviderResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.IDPMetadataURL.IsNull() || plan.IDPMetadataURL.IsUnknown() {
+		return
+	}
+
+	metadata, err := helpers.FetchSAMLMetadata(ctx, plan.IDPMetadataURL.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("idp_metadata_url"),
+			"SAML metadata not checked",
+			"The metadata could not be fetched to check its signing certificates: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(checkMetadata(metadata, plan.EntityID, path.Root("idp_metadata_url"))...)
+}
+
+// checkMetadata parses the metadata XML, reporting a mismatching entity ID,
+// invalid signing certificates and certificates close to expiry.
+func checkMetadata(metadataXML string, entityID types.String, metadataPath path.Path) (diags diag.Diagnostics) {
+	metadata, err := helpers.ParseSAMLMetadata(metadataXML)
+
+	if err != nil {
+		diags.AddAttributeError(
+			metadataPath,
+			"Invalid SAML metadata",
+			err.Error(),
+		)
+		return diags
+	}
+
+	if !entityID.IsNull() && !entityID.IsUnknown() && entityID.ValueString() != metadata.EntityID {
+		diags.AddAttributeError(
+			path.Root("entity_id"),
+			"Mismatching SAML entity ID",
+			fmt.Sprintf("The entity ID %q doesn't match the entityID %q of the metadata.", entityID.ValueString(), metadata.EntityID),
+		)
+	}
+
+	warnings, err := helpers.CheckSAMLCertificates(metadata.SigningCertificates, time.Now(), certificateExpiryWarning)
+
+	if err != nil {
+		diags.AddAttributeError(
+			metadataPath,
+			"Invalid SAML signing certificate",
+			err.Error(),
+		)
+		return diags
+	}
+
+	for _, warning := range warnings {
+		diags.AddAttributeWarning(
+			metadataPath,
+			"SAML signing certificate expiring",
+			"The metadata should be updated before the certificate expires: "+warning+".",
+		)
+	}
+
+	return diags
+}
+
+// Create a new resource.
+func (r *samlProviderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan samlProviderResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	provider := SAMLProviderToApi(plan)
+	err := r.apply(plan.ProviderID.ValueString(), &provider)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating ory SAML provider",
+			"Could not create ory SAML provider, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = plan.ProviderID
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *samlProviderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading SAML provider resource")
+
+	// Retrieve current state
+	var state samlProviderResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Fetch current project configuration from ORY
+	project, err := r.oryClient.APIClient.GetProject(&r.oryClient.Mutex)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error fetching ORY SAML provider",
+			"Could not retrieve ORY SAML provider: "+err.Error(),
+		)
+		return
+	}
+
+	live := helpers.LiveProviderMethod(project, helpers.MethodSAML)
+	index := helpers.FindProvider(live, state.ID.ValueString())
+
+	if index < 0 {
+		tflog.Debug(ctx, "SAML provider no longer exists, removing from state", map[string]interface{}{
+			"id": state.ID.ValueString(),
+		})
+
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	ApiToSAMLProvider(live.Config.Providers[index], &state)
+
+	// Set the updated state
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *samlProviderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve the desired state from the plan
+	var plan samlProviderResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	provider := SAMLProviderToApi(plan)
+	err := r.apply(plan.ProviderID.ValueString(), &provider)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating ory SAML provider",
+			"Could not update ory SAML provider, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set the updated plan to the state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete removes the provider from the SAML sign-in method, leaving the
+// method enabled.
+func (r *samlProviderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state samlProviderResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.apply(state.ProviderID.ValueString(), nil)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting ory SAML provider",
+			"Could not delete ory SAML provider, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *samlProviderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id and provider_id attributes
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughID(ctx, path.Root("provider_id"), req, resp)
+}
+
+// apply adds, replaces or, when provider is nil, removes the provider in the
+// live SAML configuration.
+func (r *samlProviderResource) apply(providerID string, provider *orytypes.Provider) error {
+	project, err := r.oryClient.APIClient.GetProject(&r.oryClient.Mutex)
+	if err != nil {
+		return err
+	}
+
+	live := helpers.LiveProviderMethod(project, helpers.MethodSAML)
+	patch := helpers.ProviderPatch(helpers.MethodSAML, live, providerID, provider)
+
+	if len(patch) == 0 {
+		return nil
+	}
+
+	_, err = r.oryClient.APIClient.PatchProject(project.RevisionId, patch, &r.oryClient.Mutex)
+
+	return err
+}
@@ -0,0 +1,73 @@
+package saml_provider_resource_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/kibblator/terraform-provider-ory/internal/provider/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOrySAMLProviderResource(t *testing.T) {
+	randomName := acctest.GenerateRandomResourceName()
+	resourceName := fmt.Sprintf("ory_saml_provider.%s", randomName)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			// Inline metadata is validated locally
+			{
+				Config: testAccOrySAMLProvider(randomName, `
+  idp_metadata_xml = "<EntityDescriptor entityID=\"https://idp.example.com\"></EntityDescriptor>"
+`),
+				ExpectError: regexp.MustCompile("Invalid SAML metadata"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccOrySAMLProvider(randomName, `
+  label            = "Example SAML"
+  idp_metadata_url = "https://idp.example.com/saml/metadata.xml"
+  entity_id        = "https://idp.example.com"
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", randomName),
+					resource.TestCheckResourceAttr(resourceName, "label", "Example SAML"),
+					resource.TestCheckResourceAttr(resourceName, "entity_id", "https://idp.example.com"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_updated"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"last_updated", // If 'last_updated' isn't returned from the ORY API, ignore it during import verification
+				},
+			},
+			// Update and Read testing
+			{
+				Config: testAccOrySAMLProvider(randomName, `
+  label            = "Example SAML (updated)"
+  idp_metadata_url = "https://idp.example.com/saml/metadata.xml"
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "label", "Example SAML (updated)"),
+					resource.TestCheckNoResourceAttr(resourceName, "entity_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOrySAMLProvider(randomName string, attributes string) string {
+	return fmt.Sprintf(`
+resource "ory_saml_provider" "%[1]s" {
+  provider_id = "%[1]s"
+  mapper_url  = "base64://bG9jYWwgY2xhaW1zID0gc3RkLmV4dFZhcignY2xhaW1zJyk7IHsgaWRlbnRpdHk6IHsgdHJhaXRzOiB7IGVtYWlsOiBjbGFpbXMuZW1haWwgfSB9IH0="
+%[2]s}
+`, randomName, attributes)
+}
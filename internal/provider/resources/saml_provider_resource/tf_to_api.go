@@ -0,0 +1,30 @@
+package saml_provider_resource
+
+import (
+	"regexp"
+
+	"github.com/kibblator/terraform-provider-ory/internal/provider/helpers"
+	orytypes "github.com/kibblator/terraform-provider-ory/internal/provider/types"
+)
+
+var providerIDPattern = regexp.MustCompile(`^[a-z0-9_-]+$`)
+
+// SAMLProviderToApi maps the model onto the provider configuration. Inline
+// metadata is sent as a base64:// URL.
+func SAMLProviderToApi(tfConfig samlProviderResourceModel) orytypes.Provider {
+	provider := orytypes.Provider{
+		ID:             tfConfig.ProviderID.ValueString(),
+		Provider:       "generic",
+		Label:          tfConfig.Label.ValueString(),
+		IDPMetadataURL: tfConfig.IDPMetadataURL.ValueString(),
+		EntityID:       tfConfig.EntityID.ValueString(),
+		MapperURL:      tfConfig.MapperURL.ValueString(),
+		OrganizationID: tfConfig.OrganizationID.ValueString(),
+	}
+
+	if !tfConfig.IDPMetadataXML.IsNull() {
+		provider.RawIDPMetadata = helpers.EncodeBase64URL(tfConfig.IDPMetadataXML.ValueString())
+	}
+
+	return provider
+}
@@ -0,0 +1,63 @@
+package session_settings_resource
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/helpers"
+	orytypes "github.com/kibblator/terraform-provider-ory/internal/provider/types"
+)
+
+// ApiToSessionSettings maps the live session settings onto the model, only
+// touching the cookie fields and templates the model manages.
+func ApiToSessionSettings(session *orytypes.Session, tfConfig *sessionSettingsResourceModel) {
+	if session == nil {
+		session = &orytypes.Session{}
+	}
+
+	tfConfig.Lifespan = helpers.DurationOrState(session.Lifespan, tfConfig.Lifespan)
+	tfConfig.EarliestPossibleExtend = helpers.DurationOrState(session.EarliestPossibleExtend, tfConfig.EarliestPossibleExtend)
+
+	if tfConfig.Cookie != nil {
+		cookie := orytypes.SessionCookie{}
+		if session.Cookie != nil {
+			cookie = *session.Cookie
+		}
+
+		if !tfConfig.Cookie.Name.IsNull() {
+			tfConfig.Cookie.Name = helpers.StringOrNil(cookie.Name)
+		}
+
+		if !tfConfig.Cookie.Domain.IsNull() {
+			tfConfig.Cookie.Domain = helpers.StringOrNil(cookie.Domain)
+		}
+
+		if !tfConfig.Cookie.SameSite.IsNull() {
+			tfConfig.Cookie.SameSite = helpers.StringOrNil(cookie.SameSite)
+		}
+
+		if !tfConfig.Cookie.Persistent.IsNull() {
+			tfConfig.Cookie.Persistent = types.BoolPointerValue(cookie.Persistent)
+		}
+
+		if !tfConfig.Cookie.Path.IsNull() {
+			tfConfig.Cookie.Path = helpers.StringOrNil(cookie.Path)
+		}
+	}
+
+	if tfConfig.TokenizerTemplates != nil {
+		templates := map[string]TokenizerTemplate{}
+
+		if session.WhoAmI != nil && session.WhoAmI.Tokenizer != nil {
+			previous := *tfConfig.TokenizerTemplates
+
+			for name, template := range session.WhoAmI.Tokenizer.Templates {
+				templates[name] = TokenizerTemplate{
+					TTL:             helpers.DurationOrState(template.TTL, previous[name].TTL),
+					ClaimsMapperURL: helpers.StringOrNil(template.ClaimsMapperURL),
+					JWKSURL:         helpers.StringOrNil(template.JWKSURL),
+				}
+			}
+		}
+
+		tfConfig.TokenizerTemplates = &templates
+	}
+}
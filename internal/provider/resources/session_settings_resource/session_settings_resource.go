@@ -0,0 +1,320 @@
+package session_settings_resource
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	oryclient "github.com/kibblator/terraform-provider-ory/internal/provider/clients"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/custom_validators"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &sessionSettingsResource{}
+	_ resource.ResourceWithConfigure   = &sessionSettingsResource{}
+	_ resource.ResourceWithImportState = &sessionSettingsResource{}
+)
+
+// NewSessionSettingsResource is a helper function to simplify the provider implementation.
+func NewSessionSettingsResource() resource.Resource {
+	return &sessionSettingsResource{}
+}
+
+// sessionSettingsResource is the resource implementation.
+type sessionSettingsResource struct {
+	oryClient *oryclient.OryClient
+}
+
+type SessionCookie struct {
+	Name       types.String `tfsdk:"name"`
+	Domain     types.String `tfsdk:"domain"`
+	SameSite   types.String `tfsdk:"same_site"`
+	Persistent types.Bool   `tfsdk:"persistent"`
+	Path       types.String `tfsdk:"path"`
+}
+
+type TokenizerTemplate struct {
+	TTL             types.String `tfsdk:"ttl"`
+	ClaimsMapperURL types.String `tfsdk:"claims_mapper_url"`
+	JWKSURL         types.String `tfsdk:"jwks_url"`
+}
+
+// sessionSettingsResourceModel maps the resource schema data.
+type sessionSettingsResourceModel struct {
+	ID                     types.String                  `tfsdk:"id"`
+	LastUpdated            types.String                  `tfsdk:"last_updated"`
+	Lifespan               types.String                  `tfsdk:"lifespan"`
+	EarliestPossibleExtend types.String                  `tfsdk:"earliest_possible_extend"`
+	Cookie                 *SessionCookie                `tfsdk:"cookie"`
+	TokenizerTemplates     *map[string]TokenizerTemplate `tfsdk:"tokenizer_templates"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *sessionSettingsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*oryclient.OryClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected OryClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.oryClient = client
+}
+
+// Metadata returns the resource type name.
+func (r *sessionSettingsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_session_settings"
+}
+
+// Schema defines the schema for the resource.
+func (r *sessionSettingsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "String identifier of the session settings resource.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update of the session settings.",
+				Computed:    true,
+			},
+			"lifespan": schema.StringAttribute{
+				Description: "How long a session is valid for, as a duration such as `24h`.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					custom_validators.DurationValidator{},
+				},
+			},
+			"earliest_possible_extend": schema.StringAttribute{
+				Description: "How long before expiry a session can be extended, as a duration such as `1h`.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					custom_validators.DurationValidator{},
+				},
+			},
+			"cookie": schema.SingleNestedAttribute{
+				Description: "Session cookie settings. Only the configured fields are managed.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Description: "Name of the session cookie.",
+						Optional:    true,
+					},
+					"domain": schema.StringAttribute{
+						Description: "Domain of the session cookie.",
+						Optional:    true,
+					},
+					"same_site": schema.StringAttribute{
+						Description: "SameSite attribute of the session cookie.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("Lax", "Strict", "None"),
+						},
+					},
+					"persistent": schema.BoolAttribute{
+						Description: "If enabled, the session cookie outlives the browser session.",
+						Optional:    true,
+					},
+					"path": schema.StringAttribute{
+						Description: "Path of the session cookie.",
+						Optional:    true,
+					},
+				},
+			},
+			"tokenizer_templates": schema.MapNestedAttribute{
+				Description: "JWT templates used to tokenize sessions through the whoami endpoint, keyed by template name. The configured templates replace all existing ones.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"ttl": schema.StringAttribute{
+							Description: "How long the issued JWT is valid for, as a duration such as `1m`.",
+							Optional:    true,
+							Validators: []validator.String{
+								custom_validators.DurationValidator{},
+							},
+						},
+						"claims_mapper_url": schema.StringAttribute{
+							Description: "URL of the Jsonnet claims mapper, for example `base64://...` or `https://...`.",
+							Optional:    true,
+							Validators: []validator.String{
+								custom_validators.URLValidator{Schemes: []string{"http", "https", "file", "base64"}},
+							},
+						},
+						"jwks_url": schema.StringAttribute{
+							Description: "URL of the JSON Web Key Set used to sign the JWT, for example `base64://...` or `https://...`.",
+							Required:    true,
+							Validators: []validator.String{
+								custom_validators.URLValidator{Schemes: []string{"http", "https", "file", "base64"}},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Create a new resource.
+func (r *sessionSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan sessionSettingsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project, err := r.oryClient.APIClient.GetProject(&r.oryClient.Mutex)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error fetching ORY session settings",
+			"Could not retrieve ORY session settings: "+err.Error(),
+		)
+		return
+	}
+
+	patch := SessionSettingsToApi(plan, nil, project.Services.Identity.Config.Session)
+
+	projectUpdate, err := r.oryClient.APIClient.PatchProject(project.RevisionId, patch, &r.oryClient.Mutex)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating ory session settings",
+			"Could not create ory session settings, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.StringValue("session_settings")
+	ApiToSessionSettings(projectUpdate.Project.Services.Identity.Config.Session, &plan)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *sessionSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading session settings resource")
+
+	// Retrieve current state
+	var state sessionSettingsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Fetch current project configuration from ORY
+	project, err := r.oryClient.APIClient.GetProject(&r.oryClient.Mutex)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error fetching ORY session settings",
+			"Could not retrieve ORY session settings: "+err.Error(),
+		)
+		return
+	}
+
+	ApiToSessionSettings(project.Services.Identity.Config.Session, &state)
+
+	tflog.Debug(ctx, "Updated State", map[string]interface{}{
+		"state": state,
+	})
+
+	// Set the updated state
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *sessionSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve the desired state from the plan
+	var plan, state sessionSettingsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	project, err := r.oryClient.APIClient.GetProject(&r.oryClient.Mutex)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error fetching ORY session settings",
+			"Could not retrieve ORY session settings: "+err.Error(),
+		)
+		return
+	}
+
+	patch := SessionSettingsToApi(plan, &state, project.Services.Identity.Config.Session)
+
+	projectUpdate, err := r.oryClient.APIClient.PatchProject(project.RevisionId, patch, &r.oryClient.Mutex)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating ory session settings",
+			"Could not update ory session settings, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	ApiToSessionSettings(projectUpdate.Project.Services.Identity.Config.Session, &plan)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set the updated plan to the state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *sessionSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+func (r *sessionSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,81 @@
+package session_settings_resource_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/kibblator/terraform-provider-ory/internal/provider/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOrySessionSettingsResource(t *testing.T) {
+	randomName := acctest.GenerateRandomResourceName()
+	resourceName := fmt.Sprintf("ory_session_settings.%s", randomName)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			// Invalid durations are rejected at plan time
+			{
+				Config: fmt.Sprintf(`
+resource "ory_session_settings" "%s" {
+  lifespan = "three days"
+}
+`, randomName),
+				ExpectError: regexp.MustCompile("Invalid Duration"),
+			},
+			// Create and Read testing
+			{
+				Config: fmt.Sprintf(`
+resource "ory_session_settings" "%s" {
+  lifespan                 = "72h"
+  earliest_possible_extend = "24h"
+
+  cookie = {
+    same_site  = "Lax"
+    persistent = true
+  }
+}
+`, randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "session_settings"),
+					resource.TestCheckResourceAttr(resourceName, "lifespan", "72h"),
+					resource.TestCheckResourceAttr(resourceName, "earliest_possible_extend", "24h"),
+					resource.TestCheckResourceAttr(resourceName, "cookie.same_site", "Lax"),
+					resource.TestCheckResourceAttr(resourceName, "cookie.persistent", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_updated"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: fmt.Sprintf(`
+resource "ory_session_settings" "%s" {
+  lifespan = "24h"
+
+  cookie = {
+    same_site  = "Strict"
+    persistent = false
+  }
+
+  tokenizer_templates = {
+    example = {
+      ttl      = "1m"
+      jwks_url = "https://example.com/.well-known/jwks.json"
+    }
+  }
+}
+`, randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "lifespan", "24h"),
+					resource.TestCheckResourceAttr(resourceName, "cookie.same_site", "Strict"),
+					resource.TestCheckResourceAttr(resourceName, "cookie.persistent", "false"),
+					resource.TestCheckResourceAttr(resourceName, "tokenizer_templates.example.ttl", "1m"),
+					resource.TestCheckResourceAttr(resourceName, "tokenizer_templates.example.jwks_url", "https://example.com/.well-known/jwks.json"),
+				),
+			},
+		},
+	})
+}
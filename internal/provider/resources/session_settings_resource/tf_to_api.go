@@ -0,0 +1,101 @@
+package session_settings_resource
+
+import (
+	orytypes "github.com/kibblator/terraform-provider-ory/internal/provider/types"
+	"github.com/ory/client-go"
+)
+
+// SessionSettingsToApi returns the patch applying the planned session settings
+// on top of the live ones. Nested objects are written as a whole, merged with
+// their live values, so the patch doesn't depend on which parents exist.
+func SessionSettingsToApi(plan sessionSettingsResourceModel, state *sessionSettingsResourceModel, session *orytypes.Session) []client.JsonPatch {
+	var patch []client.JsonPatch
+
+	if session == nil {
+		session = &orytypes.Session{}
+		patch = append(patch, client.JsonPatch{
+			Op:    "add",
+			Path:  "/services/identity/config/session",
+			Value: map[string]interface{}{},
+		})
+	}
+
+	if !plan.Lifespan.IsNull() && !plan.Lifespan.IsUnknown() {
+		patch = append(patch, client.JsonPatch{
+			Op:    "add",
+			Path:  "/services/identity/config/session/lifespan",
+			Value: plan.Lifespan.ValueString(),
+		})
+	}
+
+	if !plan.EarliestPossibleExtend.IsNull() && !plan.EarliestPossibleExtend.IsUnknown() {
+		patch = append(patch, client.JsonPatch{
+			Op:    "add",
+			Path:  "/services/identity/config/session/earliest_possible_extend",
+			Value: plan.EarliestPossibleExtend.ValueString(),
+		})
+	}
+
+	if plan.Cookie != nil {
+		cookie := orytypes.SessionCookie{}
+		if session.Cookie != nil {
+			cookie = *session.Cookie
+		}
+
+		if !plan.Cookie.Name.IsNull() {
+			cookie.Name = plan.Cookie.Name.ValueString()
+		}
+
+		if !plan.Cookie.Domain.IsNull() {
+			cookie.Domain = plan.Cookie.Domain.ValueString()
+		}
+
+		if !plan.Cookie.SameSite.IsNull() {
+			cookie.SameSite = plan.Cookie.SameSite.ValueString()
+		}
+
+		if !plan.Cookie.Persistent.IsNull() {
+			cookie.Persistent = plan.Cookie.Persistent.ValueBoolPointer()
+		}
+
+		if !plan.Cookie.Path.IsNull() {
+			cookie.Path = plan.Cookie.Path.ValueString()
+		}
+
+		patch = append(patch, client.JsonPatch{
+			Op:    "add",
+			Path:  "/services/identity/config/session/cookie",
+			Value: cookie,
+		})
+	}
+
+	// Templates are authoritative, removing the attribute removes the templates it managed
+	if plan.TokenizerTemplates != nil || (state != nil && state.TokenizerTemplates != nil) {
+		whoAmI := orytypes.WhoAmI{}
+		if session.WhoAmI != nil {
+			whoAmI = *session.WhoAmI
+		}
+
+		templates := map[string]orytypes.TokenizerTemplate{}
+
+		if plan.TokenizerTemplates != nil {
+			for name, template := range *plan.TokenizerTemplates {
+				templates[name] = orytypes.TokenizerTemplate{
+					TTL:             template.TTL.ValueString(),
+					ClaimsMapperURL: template.ClaimsMapperURL.ValueString(),
+					JWKSURL:         template.JWKSURL.ValueString(),
+				}
+			}
+		}
+
+		whoAmI.Tokenizer = &orytypes.Tokenizer{Templates: templates}
+
+		patch = append(patch, client.JsonPatch{
+			Op:    "add",
+			Path:  "/services/identity/config/session/whoami",
+			Value: whoAmI,
+		})
+	}
+
+	return patch
+}
@@ -0,0 +1,37 @@
+package trusted_oauth2_jwt_grant_issuer_resource
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/helpers"
+	"github.com/ory/client-go"
+)
+
+// ApiToTrustedIssuer maps the trust relationship returned by the API onto the
+// model. The API only returns a reference to the key, so the key is kept as is.
+func ApiToTrustedIssuer(issuer *client.TrustedOAuth2JwtGrantIssuer, tfConfig *trustedOAuth2JwtGrantIssuerResourceModel) {
+	tfConfig.ID = types.StringValue(issuer.GetId())
+	tfConfig.Issuer = types.StringValue(issuer.GetIssuer())
+	tfConfig.Subject = helpers.StringOrNil(issuer.GetSubject())
+	tfConfig.AllowAnySubject = types.BoolValue(issuer.GetAllowAnySubject())
+	tfConfig.ExpiresAt = helpers.TimestampOrState(issuer.ExpiresAt, tfConfig.ExpiresAt)
+
+	scopes := make([]types.String, 0, len(issuer.Scope))
+	for _, scope := range issuer.Scope {
+		scopes = append(scopes, types.StringValue(scope))
+	}
+	tfConfig.Scopes = scopes
+
+	tfConfig.CreatedAt = types.StringNull()
+	if issuer.CreatedAt != nil {
+		tfConfig.CreatedAt = types.StringValue(issuer.CreatedAt.Format(time.RFC3339))
+	}
+
+	tfConfig.KeySetID = types.StringNull()
+	tfConfig.KeyID = types.StringNull()
+	if issuer.PublicKey != nil {
+		tfConfig.KeySetID = helpers.StringOrNil(issuer.PublicKey.GetSet())
+		tfConfig.KeyID = helpers.StringOrNil(issuer.PublicKey.GetKid())
+	}
+}
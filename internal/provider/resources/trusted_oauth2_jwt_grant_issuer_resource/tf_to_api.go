@@ -0,0 +1,58 @@
+package trusted_oauth2_jwt_grant_issuer_resource
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/ory/client-go"
+)
+
+// privateJWKParameters are the JWK parameters only present in private keys.
+var privateJWKParameters = []string{"d", "p", "q", "dp", "dq", "qi", "k"}
+
+// JWKToApi parses the public JWK of the issuer, rejecting private keys.
+func JWKToApi(value jsontypes.Normalized) (*client.JsonWebKey, error) {
+	var jwk map[string]interface{}
+	if err := json.Unmarshal([]byte(value.ValueString()), &jwk); err != nil {
+		return nil, fmt.Errorf("the JWK is not valid JSON: %w", err)
+	}
+
+	for _, parameter := range privateJWKParameters {
+		if _, found := jwk[parameter]; found {
+			return nil, fmt.Errorf("the JWK contains the private parameter %q, only public keys should be trusted", parameter)
+		}
+	}
+
+	var apiKey client.JsonWebKey
+	if err := json.Unmarshal([]byte(value.ValueString()), &apiKey); err != nil {
+		return nil, fmt.Errorf("the JWK is invalid: %w", err)
+	}
+
+	return &apiKey, nil
+}
+
+// TrustedIssuerToApi maps the model onto the trust relationship body.
+func TrustedIssuerToApi(tfConfig trustedOAuth2JwtGrantIssuerResourceModel) (*client.TrustOAuth2JwtGrantIssuer, error) {
+	jwk, err := JWKToApi(tfConfig.JWK)
+	if err != nil {
+		return nil, err
+	}
+
+	expiresAt, err := time.Parse(time.RFC3339, tfConfig.ExpiresAt.ValueString())
+	if err != nil {
+		return nil, fmt.Errorf("invalid expires_at: %w", err)
+	}
+
+	scopes := make([]string, 0, len(tfConfig.Scopes))
+	for _, scope := range tfConfig.Scopes {
+		scopes = append(scopes, scope.ValueString())
+	}
+
+	body := client.NewTrustOAuth2JwtGrantIssuer(expiresAt, tfConfig.Issuer.ValueString(), *jwk, scopes)
+	body.AllowAnySubject = tfConfig.AllowAnySubject.ValueBoolPointer()
+	body.Subject = tfConfig.Subject.ValueStringPointer()
+
+	return body, nil
+}
@@ -0,0 +1,345 @@
+package trusted_oauth2_jwt_grant_issuer_resource
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	oryclient "github.com/kibblator/terraform-provider-ory/internal/provider/clients"
+	"github.com/kibblator/terraform-provider-ory/internal/provider/custom_validators"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &trustedOAuth2JwtGrantIssuerResource{}
+	_ resource.ResourceWithConfigure      = &trustedOAuth2JwtGrantIssuerResource{}
+	_ resource.ResourceWithImportState    = &trustedOAuth2JwtGrantIssuerResource{}
+	_ resource.ResourceWithValidateConfig = &trustedOAuth2JwtGrantIssuerResource{}
+)
+
+// NewTrustedOAuth2JwtGrantIssuerResource is a helper function to simplify the provider implementation.
+func NewTrustedOAuth2JwtGrantIssuerResource() resource.Resource {
+	return &trustedOAuth2JwtGrantIssuerResource{}
+}
+
+// trustedOAuth2JwtGrantIssuerResource is the resource implementation.
+type trustedOAuth2JwtGrantIssuerResource struct {
+	oryClient *oryclient.OryClient
+}
+
+// trustedOAuth2JwtGrantIssuerResourceModel maps the resource schema data.
+type trustedOAuth2JwtGrantIssuerResourceModel struct {
+	ID              types.String         `tfsdk:"id"`
+	LastUpdated     types.String         `tfsdk:"last_updated"`
+	Issuer          types.String         `tfsdk:"issuer"`
+	Subject         types.String         `tfsdk:"subject"`
+	AllowAnySubject types.Bool           `tfsdk:"allow_any_subject"`
+	Scopes          []types.String       `tfsdk:"scopes"`
+	JWK             jsontypes.Normalized `tfsdk:"jwk"`
+	ExpiresAt       types.String         `tfsdk:"expires_at"`
+	CreatedAt       types.String         `tfsdk:"created_at"`
+	KeySetID        types.String         `tfsdk:"key_set_id"`
+	KeyID           types.String         `tfsdk:"key_id"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *trustedOAuth2JwtGrantIssuerResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*oryclient.OryClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected OryClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.oryClient = client
+}
+
+// Metadata returns the resource type name.
+func (r *trustedOAuth2JwtGrantIssuerResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_trusted_oauth2_jwt_grant_issuer"
+}
+
+// Schema defines the schema for the resource.
+func (r *trustedOAuth2JwtGrantIssuerResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Trusts an issuer of JWT assertions for the RFC 7523 JWT bearer grant. Trust relationships can't be modified, so every change replaces the relationship.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the trust relationship.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update of the trust relationship.",
+				Computed:    true,
+			},
+			"issuer": schema.StringAttribute{
+				Description: "Issuer of the JWT assertions, matched against the `iss` claim.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"subject": schema.StringAttribute{
+				Description: "Subject the issuer may issue assertions for, matched against the `sub` claim. Exactly one of subject or allow_any_subject must be set.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"allow_any_subject": schema.BoolAttribute{
+				Description: "If enabled, the issuer may issue assertions for any subject. Defaults to `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"scopes": schema.SetAttribute{
+				Description: "Scopes the issuer may request.",
+				ElementType: types.StringType,
+				Required:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"jwk": schema.StringAttribute{
+				Description: "JSON encoded public JWK used to verify the assertions. The API doesn't return the key, so it isn't read back and isn't set on import.",
+				CustomType:  jsontypes.NormalizedType{},
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						func(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+							// Imported relationships have no key in state, adding it doesn't change the relationship
+							resp.RequiresReplace = !req.StateValue.IsNull()
+						},
+						"Changing the key replaces the trust relationship.",
+						"Changing the key replaces the trust relationship.",
+					),
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				Description: "RFC 3339 timestamp after which the trust relationship expires.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					custom_validators.TimestampValidator{},
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Timestamp of the creation of the trust relationship.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"key_set_id": schema.StringAttribute{
+				Description: "ID of the JSON Web Key Set Ory stores the key in.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"key_id": schema.StringAttribute{
+				Description: "ID of the key within the key set.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks the subject settings and that the key is a public JWK.
+func (r *trustedOAuth2JwtGrantIssuerResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config trustedOAuth2JwtGrantIssuerResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.Subject.IsUnknown() && !config.AllowAnySubject.IsUnknown() {
+		hasSubject := !config.Subject.IsNull()
+		allowAny := config.AllowAnySubject.ValueBool()
+
+		if hasSubject == allowAny {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("subject"),
+				"Invalid subject configuration",
+				"Exactly one of subject or allow_any_subject = true must be set.",
+			)
+		}
+	}
+
+	if config.JWK.IsNull() || config.JWK.IsUnknown() {
+		return
+	}
+
+	if _, err := JWKToApi(config.JWK); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("jwk"),
+			"Invalid JWK",
+			err.Error(),
+		)
+	}
+}
+
+// Create a new resource.
+func (r *trustedOAuth2JwtGrantIssuerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan trustedOAuth2JwtGrantIssuerResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	body, err := TrustedIssuerToApi(plan)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating ory trusted OAuth2 JWT grant issuer",
+			"Could not create ory trusted OAuth2 JWT grant issuer, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	issuer, _, err := r.oryClient.ProjectAPIClient.OAuth2API.TrustOAuth2JwtGrantIssuer(ctx).TrustOAuth2JwtGrantIssuer(*body).Execute()
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating ory trusted OAuth2 JWT grant issuer",
+			"Could not create ory trusted OAuth2 JWT grant issuer, unexpected error: "+oryclient.ErrorDetail(err),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	ApiToTrustedIssuer(issuer, &plan)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *trustedOAuth2JwtGrantIssuerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading trusted OAuth2 JWT grant issuer resource")
+
+	// Retrieve current state
+	var state trustedOAuth2JwtGrantIssuerResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	issuer, httpResp, err := r.oryClient.ProjectAPIClient.OAuth2API.GetTrustedOAuth2JwtGrantIssuer(ctx, state.ID.ValueString()).Execute()
+
+	if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error fetching ORY trusted OAuth2 JWT grant issuer",
+			"Could not retrieve ORY trusted OAuth2 JWT grant issuer: "+oryclient.ErrorDetail(err),
+		)
+		return
+	}
+
+	ApiToTrustedIssuer(issuer, &state)
+
+	// Set the updated state
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update only stores the key of imported relationships, every other change
+// replaces the relationship.
+func (r *trustedOAuth2JwtGrantIssuerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve the desired state from the plan
+	var plan trustedOAuth2JwtGrantIssuerResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set the updated plan to the state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *trustedOAuth2JwtGrantIssuerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state trustedOAuth2JwtGrantIssuerResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpResp, err := r.oryClient.ProjectAPIClient.OAuth2API.DeleteTrustedOAuth2JwtGrantIssuer(ctx, state.ID.ValueString()).Execute()
+
+	if err != nil && (httpResp == nil || httpResp.StatusCode != http.StatusNotFound) {
+		resp.Diagnostics.AddError(
+			"Error deleting ory trusted OAuth2 JWT grant issuer",
+			"Could not delete ory trusted OAuth2 JWT grant issuer, unexpected error: "+oryclient.ErrorDetail(err),
+		)
+		return
+	}
+}
+
+func (r *trustedOAuth2JwtGrantIssuerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
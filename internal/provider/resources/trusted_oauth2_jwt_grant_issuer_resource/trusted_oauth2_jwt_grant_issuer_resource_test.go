@@ -0,0 +1,62 @@
+package trusted_oauth2_jwt_grant_issuer_resource_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kibblator/terraform-provider-ory/internal/provider/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOryTrustedOAuth2JwtGrantIssuerResource(t *testing.T) {
+	randomName := acctest.GenerateRandomResourceName()
+	resourceName := fmt.Sprintf("ory_trusted_oauth2_jwt_grant_issuer.%s", randomName)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: fmt.Sprintf(`
+resource "ory_trusted_oauth2_jwt_grant_issuer" "%[1]s" {
+  issuer     = "https://%[1]s.example.com"
+  subject    = "service-account"
+  scopes     = ["read", "write"]
+  expires_at = "2099-01-01T00:00:00Z"
+
+  jwk = jsonencode({
+    kty = "EC"
+    crv = "P-256"
+    alg = "ES256"
+    use = "sig"
+    kid = "%[1]s"
+    x   = "3-qc2Vt7w3hcimL2s4LclPJ8Dg5qPosGug3HXNN3TqQ"
+    y   = "7Xff-v1zfOP-_onNT9uAL3ILyW_HeRM2I_FfaR3oKEc"
+  })
+}
+`, randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "issuer", fmt.Sprintf("https://%s.example.com", randomName)),
+					resource.TestCheckResourceAttr(resourceName, "allow_any_subject", "false"),
+					resource.TestCheckResourceAttr(resourceName, "scopes.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "expires_at", "2099-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttrSet(resourceName, "key_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_updated"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"last_updated", // If 'last_updated' isn't returned from the ORY API, ignore it during import verification
+					"jwk",          // The key itself isn't returned by the API
+				},
+			},
+		},
+	})
+}
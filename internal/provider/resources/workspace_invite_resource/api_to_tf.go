@@ -0,0 +1,17 @@
+package workspace_invite_resource
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ory/client-go"
+)
+
+// ApiToWorkspaceInvite maps the invite returned by the API onto the model.
+func ApiToWorkspaceInvite(invite *client.MemberInvite, tfConfig *workspaceInviteResourceModel) {
+	tfConfig.ID = types.StringValue(invite.Id)
+	tfConfig.Email = types.StringValue(invite.InviteeEmail)
+	tfConfig.Status = types.StringValue(invite.Status)
+
+	if invite.GetRole() != "" {
+		tfConfig.Role = types.StringValue(invite.GetRole())
+	}
+}
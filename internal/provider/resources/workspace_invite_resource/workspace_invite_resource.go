@@ -0,0 +1,286 @@
+package workspace_invite_resource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	oryclient "github.com/kibblator/terraform-provider-ory/internal/provider/clients"
+	"github.com/ory/client-go"
+)
+
+// acceptedStatus is reported for invites that were accepted and removed by the API.
+const acceptedStatus = "accepted"
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &workspaceInviteResource{}
+	_ resource.ResourceWithConfigure   = &workspaceInviteResource{}
+	_ resource.ResourceWithImportState = &workspaceInviteResource{}
+)
+
+// NewWorkspaceInviteResource is a helper function to simplify the provider implementation.
+func NewWorkspaceInviteResource() resource.Resource {
+	return &workspaceInviteResource{}
+}
+
+// workspaceInviteResource is the resource implementation.
+type workspaceInviteResource struct {
+	oryClient *oryclient.OryClient
+}
+
+// workspaceInviteResourceModel maps the resource schema data.
+type workspaceInviteResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	LastUpdated types.String `tfsdk:"last_updated"`
+	WorkspaceID types.String `tfsdk:"workspace_id"`
+	Email       types.String `tfsdk:"email"`
+	Role        types.String `tfsdk:"role"`
+	Status      types.String `tfsdk:"status"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *workspaceInviteResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*oryclient.OryClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected OryClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.oryClient = client
+}
+
+// Metadata returns the resource type name.
+func (r *workspaceInviteResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workspace_invite"
+}
+
+// Schema defines the schema for the resource.
+func (r *workspaceInviteResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Invites a user to the workspace. Once the invite is accepted, the role of the member is managed with `ory_workspace_member`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the invite.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update of the invite.",
+				Computed:    true,
+			},
+			"workspace_id": schema.StringAttribute{
+				Description: "ID of the workspace. Defaults to the workspace of the configured project.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"email": schema.StringAttribute{
+				Description: "Email address of the invited user.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role": schema.StringAttribute{
+				Description: "Role of the user in the workspace, either `owner` or `developer`.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("owner", "developer"),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "Status of the invite, for example `pending` or `accepted`.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Create a new resource.
+func (r *workspaceInviteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan workspaceInviteResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceID, err := r.workspaceID(plan.WorkspaceID)
+
+	if err == nil {
+		var invite *client.MemberInvite
+		invite, err = r.oryClient.APIClient.CreateWorkspaceInvite(workspaceID, client.CreateWorkspaceMemberInviteBody{
+			InviteeEmail: plan.Email.ValueString(),
+			Role:         plan.Role.ValueString(),
+		})
+
+		if err == nil {
+			ApiToWorkspaceInvite(invite, &plan)
+		}
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating ory workspace invite",
+			"Could not create ory workspace invite, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.WorkspaceID = types.StringValue(workspaceID)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information. Accepted invites may be removed by the API, they
+// are kept in state as long as the user is a member of the workspace.
+func (r *workspaceInviteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading workspace invite resource")
+
+	// Retrieve current state
+	var state workspaceInviteResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceID, err := r.workspaceID(state.WorkspaceID)
+
+	var invite *client.MemberInvite
+	if err == nil {
+		invite, err = r.oryClient.APIClient.GetWorkspaceInvite(workspaceID, state.ID.ValueString())
+	}
+
+	if errors.Is(err, oryclient.ErrNotFound) && !state.Email.IsNull() {
+		_, err = r.oryClient.APIClient.GetWorkspaceMemberByEmail(workspaceID, state.Email.ValueString())
+
+		if err == nil {
+			state.Status = types.StringValue(acceptedStatus)
+		}
+	}
+
+	if errors.Is(err, oryclient.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error fetching ORY workspace invite",
+			"Could not retrieve ORY workspace invite: "+err.Error(),
+		)
+		return
+	}
+
+	if invite != nil {
+		ApiToWorkspaceInvite(invite, &state)
+	}
+	state.WorkspaceID = types.StringValue(workspaceID)
+
+	// Set the updated state
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update only refreshes the state, as every configurable attribute requires a
+// new invite.
+func (r *workspaceInviteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan workspaceInviteResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set the updated plan to the state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete revokes the invite. Members who already accepted it keep their
+// access, offboarding is done by removing their `ory_workspace_member`.
+func (r *workspaceInviteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state workspaceInviteResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.Status.ValueString() == acceptedStatus {
+		return
+	}
+
+	err := r.oryClient.APIClient.DeleteWorkspaceInvite(state.WorkspaceID.ValueString(), state.ID.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting ory workspace invite",
+			"Could not delete ory workspace invite, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *workspaceInviteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// workspaceID returns the configured workspace, or the workspace of the project.
+func (r *workspaceInviteResource) workspaceID(value types.String) (string, error) {
+	if !value.IsNull() && !value.IsUnknown() {
+		return value.ValueString(), nil
+	}
+
+	if r.oryClient.ProjectConfig.WorkspaceID == "" {
+		return "", errors.New("the project is not part of a workspace, set workspace_id")
+	}
+
+	return r.oryClient.ProjectConfig.WorkspaceID, nil
+}
@@ -0,0 +1,14 @@
+package workspace_member_resource
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ory/client-go"
+)
+
+// ApiToWorkspaceMember maps the member returned by the API onto the model.
+func ApiToWorkspaceMember(member *client.ProjectMember, tfConfig *workspaceMemberResourceModel) {
+	tfConfig.ID = types.StringValue(member.Id)
+	tfConfig.Email = types.StringValue(member.Email)
+	tfConfig.Name = types.StringValue(member.Name)
+	tfConfig.Role = types.StringValue(member.Role)
+}
@@ -0,0 +1,293 @@
+package workspace_member_resource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	oryclient "github.com/kibblator/terraform-provider-ory/internal/provider/clients"
+	"github.com/ory/client-go"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &workspaceMemberResource{}
+	_ resource.ResourceWithConfigure   = &workspaceMemberResource{}
+	_ resource.ResourceWithImportState = &workspaceMemberResource{}
+)
+
+// NewWorkspaceMemberResource is a helper function to simplify the provider implementation.
+func NewWorkspaceMemberResource() resource.Resource {
+	return &workspaceMemberResource{}
+}
+
+// workspaceMemberResource is the resource implementation.
+type workspaceMemberResource struct {
+	oryClient *oryclient.OryClient
+}
+
+// workspaceMemberResourceModel maps the resource schema data.
+type workspaceMemberResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	LastUpdated types.String `tfsdk:"last_updated"`
+	WorkspaceID types.String `tfsdk:"workspace_id"`
+	Email       types.String `tfsdk:"email"`
+	Role        types.String `tfsdk:"role"`
+	Name        types.String `tfsdk:"name"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *workspaceMemberResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*oryclient.OryClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected OryClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.oryClient = client
+}
+
+// Metadata returns the resource type name.
+func (r *workspaceMemberResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workspace_member"
+}
+
+// Schema defines the schema for the resource.
+func (r *workspaceMemberResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the role of a member of the workspace. The user has to be a member already, for example by accepting an `ory_workspace_invite`. Destroying the resource removes the member from the workspace.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID of the member.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Description: "Timestamp of the last Terraform update of the member.",
+				Computed:    true,
+			},
+			"workspace_id": schema.StringAttribute{
+				Description: "ID of the workspace. Defaults to the workspace of the configured project.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"email": schema.StringAttribute{
+				Description: "Email address of the member.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role": schema.StringAttribute{
+				Description: "Role of the member in the workspace, either `owner` or `developer`.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("owner", "developer"),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the member.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Create assigns the role to an existing member.
+func (r *workspaceMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan workspaceMemberResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceID, err := r.workspaceID(plan.WorkspaceID)
+
+	var member *client.ProjectMember
+	if err == nil {
+		member, err = r.oryClient.APIClient.GetWorkspaceMemberByEmail(workspaceID, plan.Email.ValueString())
+	}
+
+	if errors.Is(err, oryclient.ErrNotFound) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("email"),
+			"Unknown ory workspace member",
+			fmt.Sprintf("No member with the email address %q exists in the workspace. Invite the user with ory_workspace_invite and apply again once the invite has been accepted.", plan.Email.ValueString()),
+		)
+		return
+	}
+
+	if err == nil && member.Role != plan.Role.ValueString() {
+		err = r.oryClient.APIClient.SetWorkspaceMemberRole(workspaceID, member.Id, plan.Role.ValueString())
+		member.Role = plan.Role.ValueString()
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating ory workspace member",
+			"Could not create ory workspace member, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	ApiToWorkspaceMember(member, &plan)
+	plan.WorkspaceID = types.StringValue(workspaceID)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *workspaceMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading workspace member resource")
+
+	// Retrieve current state
+	var state workspaceMemberResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceID, err := r.workspaceID(state.WorkspaceID)
+
+	var members []client.ProjectMember
+	if err == nil {
+		members, err = r.oryClient.APIClient.ListWorkspaceMembers(workspaceID)
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error fetching ORY workspace member",
+			"Could not retrieve ORY workspace member: "+err.Error(),
+		)
+		return
+	}
+
+	var member *client.ProjectMember
+	for i := range members {
+		if members[i].Id == state.ID.ValueString() {
+			member = &members[i]
+		}
+	}
+
+	if member == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	ApiToWorkspaceMember(member, &state)
+	state.WorkspaceID = types.StringValue(workspaceID)
+
+	// Set the updated state
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *workspaceMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve the desired state from the plan
+	var plan workspaceMemberResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.oryClient.APIClient.SetWorkspaceMemberRole(plan.WorkspaceID.ValueString(), plan.ID.ValueString(), plan.Role.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating ory workspace member",
+			"Could not update ory workspace member, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC3339))
+
+	// Set the updated plan to the state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete removes the member from the workspace.
+func (r *workspaceMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state workspaceMemberResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.oryClient.APIClient.RemoveWorkspaceMember(state.WorkspaceID.ValueString(), state.ID.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting ory workspace member",
+			"Could not delete ory workspace member, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *workspaceMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// workspaceID returns the configured workspace, or the workspace of the project.
+func (r *workspaceMemberResource) workspaceID(value types.String) (string, error) {
+	if !value.IsNull() && !value.IsUnknown() {
+		return value.ValueString(), nil
+	}
+
+	if r.oryClient.ProjectConfig.WorkspaceID == "" {
+		return "", errors.New("the project is not part of a workspace, set workspace_id")
+	}
+
+	return r.oryClient.ProjectConfig.WorkspaceID, nil
+}
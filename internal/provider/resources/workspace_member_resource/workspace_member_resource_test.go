@@ -0,0 +1,32 @@
+package workspace_member_resource_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/kibblator/terraform-provider-ory/internal/provider/acctest"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOryWorkspaceMemberResource(t *testing.T) {
+	randomName := acctest.GenerateRandomResourceName()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.TestAccProtoV6ProviderFactories,
+		PreCheck:                 func() { acctest.TestAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			// Users have to accept an invite before their role can be managed
+			{
+				Config: fmt.Sprintf(`
+resource "ory_workspace_member" "%s" {
+  email = "%s@example.com"
+  role  = "developer"
+}
+`, randomName, randomName),
+				ExpectError: regexp.MustCompile("Unknown ory workspace member"),
+			},
+		},
+	})
+}
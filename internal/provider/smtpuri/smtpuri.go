@@ -0,0 +1,169 @@
+// Package smtpuri converts between Ory courier SMTP connection URIs and the
+// typed settings exposed by the provider.
+package smtpuri
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"slices"
+	"strconv"
+)
+
+const (
+	SecurityStartTLS           = "starttls"
+	SecurityStartTLSNoTrust    = "starttls_notrust"
+	SecurityCleartext          = "cleartext"
+	SecurityImplicitTLS        = "implicittls"
+	SecurityImplicitTLSNoTrust = "implicittls_notrust"
+)
+
+// SecurityTypes lists every supported security type.
+var SecurityTypes = []string{
+	SecurityStartTLS,
+	SecurityStartTLSNoTrust,
+	SecurityCleartext,
+	SecurityImplicitTLS,
+	SecurityImplicitTLSNoTrust,
+}
+
+const (
+	paramSkipSSLVerify   = "skip_ssl_verify"
+	paramDisableStartTLS = "disable_starttls"
+	paramServerName      = "server_name"
+)
+
+// DerivedParams lists the query parameters derived from the security type and
+// server name. Parse always maps them onto those fields, so they never end up
+// in Params, and setting them as additional parameters would conflict.
+var DerivedParams = []string{paramSkipSSLVerify, paramDisableStartTLS, paramServerName}
+
+// URI is a parsed SMTP connection URI. Query parameters that are not mapped
+// onto a typed field are kept in Params so that no information is lost.
+type URI struct {
+	Username   string
+	Password   string
+	Host       string
+	Port       string
+	Path       string
+	Security   string
+	ServerName string
+	Params     url.Values
+}
+
+// Parse parses an SMTP connection URI. The security type is derived from the
+// scheme together with the skip_ssl_verify and disable_starttls parameters,
+// independently of the order they appear in. Only the first value of a derived
+// parameter is used, and flags that don't affect the security type, such as
+// skip_ssl_verify without TLS, are dropped.
+func Parse(uri string) (URI, error) {
+	parsedURL, err := url.Parse(uri)
+	if err != nil {
+		return URI{}, err
+	}
+
+	if parsedURL.Scheme != "smtp" && parsedURL.Scheme != "smtps" {
+		return URI{}, fmt.Errorf("unsupported SMTP URI scheme %q, expected smtp or smtps", parsedURL.Scheme)
+	}
+
+	result := URI{
+		Host:   parsedURL.Hostname(),
+		Port:   parsedURL.Port(),
+		Params: parsedURL.Query(),
+	}
+
+	// An empty path and the root path are equivalent
+	if parsedURL.Path != "/" {
+		result.Path = parsedURL.Path
+	}
+
+	if parsedURL.User != nil {
+		result.Username = parsedURL.User.Username()
+		result.Password, _ = parsedURL.User.Password()
+	}
+
+	skipSSLVerify := consumeBool(result.Params, paramSkipSSLVerify)
+	disableStartTLS := consumeBool(result.Params, paramDisableStartTLS)
+
+	switch {
+	case parsedURL.Scheme == "smtps" && skipSSLVerify:
+		result.Security = SecurityImplicitTLSNoTrust
+	case parsedURL.Scheme == "smtps":
+		result.Security = SecurityImplicitTLS
+	case disableStartTLS:
+		result.Security = SecurityCleartext
+	case skipSSLVerify:
+		result.Security = SecurityStartTLSNoTrust
+	default:
+		result.Security = SecurityStartTLS
+	}
+
+	result.ServerName = result.Params.Get(paramServerName)
+	result.Params.Del(paramServerName)
+
+	if len(result.Params) == 0 {
+		result.Params = nil
+	}
+
+	return result, nil
+}
+
+// String builds the SMTP connection URI. Query parameters are sorted by key
+// so the same settings always produce the same URI.
+func (u URI) String() string {
+	security := u.Security
+	if security == "" {
+		security = SecurityStartTLS
+	}
+
+	query := url.Values{}
+	for key, values := range u.Params {
+		query[key] = slices.Clone(values)
+	}
+
+	scheme := "smtp"
+
+	switch security {
+	case SecurityStartTLSNoTrust:
+		query.Set(paramSkipSSLVerify, "true")
+	case SecurityCleartext:
+		query.Set(paramDisableStartTLS, "true")
+	case SecurityImplicitTLS:
+		scheme = "smtps"
+	case SecurityImplicitTLSNoTrust:
+		scheme = "smtps"
+		query.Set(paramSkipSSLVerify, "true")
+	}
+
+	if u.ServerName != "" {
+		query.Set(paramServerName, u.ServerName)
+	}
+
+	result := url.URL{
+		Scheme:   scheme,
+		Host:     u.Host,
+		Path:     u.Path,
+		RawQuery: query.Encode(),
+	}
+
+	if u.Port != "" {
+		result.Host = net.JoinHostPort(u.Host, u.Port)
+	}
+
+	if u.Password != "" {
+		result.User = url.UserPassword(u.Username, u.Password)
+	} else if u.Username != "" {
+		result.User = url.User(u.Username)
+	}
+
+	return result.String()
+}
+
+// consumeBool removes the parameter and reports whether its first value is
+// true. Missing and invalid values are false.
+func consumeBool(params url.Values, key string) bool {
+	value, _ := strconv.ParseBool(params.Get(key))
+	params.Del(key)
+
+	return value
+}
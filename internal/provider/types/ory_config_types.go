@@ -7,13 +7,116 @@ type ProjectConfig struct {
 }
 
 type Project struct {
-	Id         string   `json:"id,omitempty"`
-	RevisionId string   `json:"revision_id,omitempty"`
-	Services   Services `json:"services,omitempty"`
+	Id          string       `json:"id,omitempty"`
+	RevisionId  string       `json:"revision_id,omitempty"`
+	Slug        string       `json:"slug,omitempty"`
+	WorkspaceID string       `json:"workspace_id,omitempty"`
+	Services    Services     `json:"services,omitempty"`
+	CorsAdmin   *ProjectCors `json:"cors_admin,omitempty"`
+	CorsPublic  *ProjectCors `json:"cors_public,omitempty"`
+}
+
+type ProjectCors struct {
+	Enabled bool     `json:"enabled"`
+	Origins []string `json:"origins"`
 }
 
 type Services struct {
-	Identity Identity `json:"identity,omitempty"`
+	Identity   Identity   `json:"identity,omitempty"`
+	OAuth2     OAuth2     `json:"oauth2,omitempty"`
+	Permission Permission `json:"permission,omitempty"`
+}
+
+type Permission struct {
+	Config PermissionConfig `json:"config,omitempty"`
+}
+
+type PermissionConfig struct {
+	Namespaces *PermissionNamespaces `json:"namespaces,omitempty"`
+}
+
+type PermissionNamespaces struct {
+	Location string `json:"location,omitempty"`
+}
+
+// UnmarshalJSON accepts the legacy list of namespaces, which has no location,
+// next to the Ory Permission Language file reference.
+func (n *PermissionNamespaces) UnmarshalJSON(data []byte) error {
+	var legacy []interface{}
+	if err := json.Unmarshal(data, &legacy); err == nil {
+		*n = PermissionNamespaces{}
+		return nil
+	}
+
+	type permissionNamespaces PermissionNamespaces
+	return json.Unmarshal(data, (*permissionNamespaces)(n))
+}
+
+type OAuth2 struct {
+	Config OAuth2Config `json:"config,omitempty"`
+}
+
+type OAuth2Config struct {
+	OAuth2     *OAuth2Settings   `json:"oauth2,omitempty"`
+	Strategies *OAuth2Strategies `json:"strategies,omitempty"`
+	TTL        *OAuth2TTL        `json:"ttl,omitempty"`
+	URLs       *OAuth2URLs       `json:"urls,omitempty"`
+}
+
+type OAuth2Settings struct {
+	Grant     *OAuth2Grant     `json:"grant,omitempty"`
+	TokenHook *OAuth2TokenHook `json:"token_hook,omitempty"`
+}
+
+type OAuth2Grant struct {
+	JWT *OAuth2JWTGrant `json:"jwt,omitempty"`
+}
+
+type OAuth2JWTGrant struct {
+	IatOptional *bool  `json:"iat_optional,omitempty"`
+	JtiOptional *bool  `json:"jti_optional,omitempty"`
+	MaxTTL      string `json:"max_ttl,omitempty"`
+}
+
+type OAuth2TokenHook struct {
+	URL string `json:"url,omitempty"`
+}
+
+// UnmarshalJSON accepts both the legacy form of the token hook, a plain URL,
+// and the webhook object.
+func (h *OAuth2TokenHook) UnmarshalJSON(data []byte) error {
+	var url string
+	if err := json.Unmarshal(data, &url); err == nil {
+		h.URL = url
+		return nil
+	}
+
+	type tokenHook OAuth2TokenHook
+	return json.Unmarshal(data, (*tokenHook)(h))
+}
+
+type OAuth2Strategies struct {
+	AccessToken string `json:"access_token,omitempty"`
+}
+
+type OAuth2TTL struct {
+	AccessToken  string `json:"access_token,omitempty"`
+	AuthCode     string `json:"auth_code,omitempty"`
+	IDToken      string `json:"id_token,omitempty"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+}
+
+type OAuth2URLs struct {
+	Consent            string          `json:"consent,omitempty"`
+	Error              string          `json:"error,omitempty"`
+	Login              string          `json:"login,omitempty"`
+	Logout             string          `json:"logout,omitempty"`
+	PostLogoutRedirect string          `json:"post_logout_redirect,omitempty"`
+	Self               *OAuth2SelfURLs `json:"self,omitempty"`
+}
+
+type OAuth2SelfURLs struct {
+	Issuer string `json:"issuer,omitempty"`
 }
 
 type Identity struct {
@@ -24,15 +127,78 @@ type Config struct {
 	Clients     *Clients     `json:"clients,omitempty"`
 	Courier     *Courier     `json:"courier,omitempty"`
 	SelfService *SelfService `json:"selfservice,omitempty"`
+	Session     *Session     `json:"session,omitempty"`
+}
+
+type Session struct {
+	Cookie                 *SessionCookie `json:"cookie,omitempty"`
+	EarliestPossibleExtend string         `json:"earliest_possible_extend,omitempty"`
+	Lifespan               string         `json:"lifespan,omitempty"`
+	WhoAmI                 *WhoAmI        `json:"whoami,omitempty"`
+}
+
+type SessionCookie struct {
+	Domain     string `json:"domain,omitempty"`
+	Name       string `json:"name,omitempty"`
+	Path       string `json:"path,omitempty"`
+	Persistent *bool  `json:"persistent,omitempty"`
+	SameSite   string `json:"same_site,omitempty"`
+}
+
+type WhoAmI struct {
+	RequiredAal string     `json:"required_aal,omitempty"`
+	Tokenizer   *Tokenizer `json:"tokenizer,omitempty"`
+}
+
+type Tokenizer struct {
+	Templates map[string]TokenizerTemplate `json:"templates"`
+}
+
+type TokenizerTemplate struct {
+	ClaimsMapperURL string `json:"claims_mapper_url,omitempty"`
+	JWKSURL         string `json:"jwks_url"`
+	TTL             string `json:"ttl,omitempty"`
 }
 
 type SelfService struct {
-	Flows   *Flows   `json:"flows,omitempty"`
-	Methods *Methods `json:"methods,omitempty"`
+	AllowedReturnURLs       []string `json:"allowed_return_urls,omitempty"`
+	DefaultBrowserReturnURL string   `json:"default_browser_return_url,omitempty"`
+	Flows                   *Flows   `json:"flows,omitempty"`
+	Methods                 *Methods `json:"methods,omitempty"`
 }
 
 type Methods struct {
-	Password PasswordMethod `json:"password,omitempty"`
+	Password PasswordMethod  `json:"password,omitempty"`
+	OIDC     *ProviderMethod `json:"oidc,omitempty"`
+	SAML     *ProviderMethod `json:"saml,omitempty"`
+}
+
+// ProviderMethod is a sign-in method backed by external identity providers,
+// such as OIDC and SAML.
+type ProviderMethod struct {
+	Config  *ProviderMethodConfig `json:"config,omitempty"`
+	Enabled bool                  `json:"enabled"`
+}
+
+type ProviderMethodConfig struct {
+	Providers []Provider `json:"providers"`
+}
+
+type Provider struct {
+	ID             string   `json:"id"`
+	Provider       string   `json:"provider,omitempty"`
+	Label          string   `json:"label,omitempty"`
+	ClientID       string   `json:"client_id,omitempty"`
+	ClientSecret   string   `json:"client_secret,omitempty"`
+	IssuerURL      string   `json:"issuer_url,omitempty"`
+	AuthURL        string   `json:"auth_url,omitempty"`
+	TokenURL       string   `json:"token_url,omitempty"`
+	Scope          []string `json:"scope,omitempty"`
+	MapperURL      string   `json:"mapper_url,omitempty"`
+	OrganizationID string   `json:"organization_id,omitempty"`
+	IDPMetadataURL string   `json:"idp_metadata_url,omitempty"`
+	RawIDPMetadata string   `json:"raw_idp_metadata_xml,omitempty"`
+	EntityID       string   `json:"entity_id,omitempty"`
 }
 
 type PasswordMethod struct {
@@ -101,10 +267,13 @@ type Courier struct {
 }
 
 type SMTP struct {
-	ConnectionUri string            `json:"connection_uri,omitempty"`
-	FromAddress   string            `json:"from_address,omitempty"`
-	FromName      string            `json:"from_name,omitempty"`
-	Headers       map[string]string `json:"headers,omitempty"`
+	ConnectionUri  string            `json:"connection_uri,omitempty"`
+	FromAddress    string            `json:"from_address,omitempty"`
+	FromName       string            `json:"from_name,omitempty"`
+	Headers        map[string]string `json:"headers,omitempty"`
+	LocalName      string            `json:"local_name,omitempty"`
+	ClientCertPath string            `json:"client_cert_path,omitempty"`
+	ClientKeyPath  string            `json:"client_key_path,omitempty"`
 }
 
 type HTTP struct {